@@ -15,7 +15,6 @@
 package main
 
 import (
-	"log"
 	"os"
 	"os/signal"
 	"syscall"
@@ -27,7 +26,9 @@ import (
 	sqs_service "github.com/nitrictech/nitric/cloud/aws/runtime/queue"
 	secrets_manager_secret_service "github.com/nitrictech/nitric/cloud/aws/runtime/secret"
 	s3_service "github.com/nitrictech/nitric/cloud/aws/runtime/storage"
+	local_events "github.com/nitrictech/nitric/cloud/common/runtime/events"
 	base_http "github.com/nitrictech/nitric/cloud/common/runtime/gateway"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/membrane"
 	"github.com/nitrictech/nitric/core/pkg/utils"
 )
@@ -43,7 +44,7 @@ func main() {
 
 	provider, err := core.New()
 	if err != nil {
-		log.Fatalf("could not create aws provider: %v", err)
+		logger.Default().WithError(err).Fatal("could not create aws provider")
 		return
 	}
 
@@ -57,15 +58,20 @@ func main() {
 
 	membraneOpts.SecretPlugin, _ = secrets_manager_secret_service.New(provider)
 	membraneOpts.DocumentPlugin, _ = dynamodb_service.New(provider)
-	membraneOpts.EventsPlugin, _ = sns_service.New(provider)
+	if local_events.Enabled() {
+		membraneOpts.CreateEventsPlugin = local_events.New
+	} else {
+		membraneOpts.EventsPlugin, _ = sns_service.New(provider)
+	}
 	membraneOpts.QueuePlugin, _ = sqs_service.New(provider)
 	membraneOpts.StoragePlugin, _ = s3_service.New(provider)
 	membraneOpts.ResourcesPlugin = provider
 	membraneOpts.CreateTracerProvider = newTracerProvider
+	membraneOpts.CreateMeterProvider = newMeterProvider
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
-		log.Default().Fatalf("There was an error initialising the membrane server: %v", err)
+		logger.Default().WithError(err).Fatal("could not initialise the membrane server")
 	}
 
 	errChan := make(chan error)
@@ -76,9 +82,9 @@ func main() {
 
 	select {
 	case membraneError := <-errChan:
-		log.Default().Printf("Membrane Error: %v, exiting\n", membraneError)
+		logger.Default().WithError(membraneError).Error("membrane error, exiting")
 	case sigTerm := <-term:
-		log.Default().Printf("Received %v, exiting\n", sigTerm)
+		logger.Default().WithField("signal", sigTerm).Info("received signal, exiting")
 	}
 
 	m.Stop()
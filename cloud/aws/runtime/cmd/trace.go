@@ -18,39 +18,41 @@ import (
 	"context"
 
 	"github.com/aws/aws-lambda-go/lambdacontext"
-	"github.com/pkg/errors"
 	lambdadetector "go.opentelemetry.io/contrib/detectors/aws/lambda"
 	"go.opentelemetry.io/contrib/propagators/aws/xray"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
 	"github.com/nitrictech/nitric/core/pkg/span"
-	"github.com/nitrictech/nitric/core/pkg/utils"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 )
 
+// newResource - describes the membrane in exported telemetry
+func newResource(ctx context.Context) (*resource.Resource, error) {
+	return resource.New(ctx,
+		resource.WithDetectors(lambdadetector.NewResourceDetector()),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.CloudProviderAWS,
+			semconv.CloudPlatformAWSLambda,
+		),
+		resource.WithAttributes(tracing.ResourceAttributes(lambdacontext.FunctionName)...),
+	)
+}
+
 func newTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
 	span.FunctionName = lambdacontext.FunctionName
 	span.UseFuncNameAsSpanName = true
 
-	exp, err := otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	exp, err := tracing.NewSpanExporter(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := resource.New(ctx,
-		resource.WithDetectors(lambdadetector.NewResourceDetector()),
-		resource.WithTelemetrySDK(),
-		resource.WithAttributes(
-			semconv.CloudProviderAWS,
-			semconv.CloudPlatformAWSLambda,
-			semconv.ServiceNameKey.String(span.FunctionName),
-			semconv.ServiceNamespaceKey.String(utils.GetEnv("NITRIC_STACK", "")),
-		),
-	)
+	res, err := newResource(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -61,13 +63,13 @@ func newTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
 			propagation.TraceContext{},
 		))
 
-	rate, err := utils.PercentFromIntString(utils.GetEnv("NITRIC_TRACE_SAMPLE_PERCENT", "10"))
+	sampler, err := tracing.Sampler()
 	if err != nil {
-		return nil, errors.WithMessagef(err, "NITRIC_TRACE_SAMPLE_PERCENT should be an int")
+		return nil, err
 	}
 
 	return sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(rate))),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithBatcher(exp),
 		sdktrace.WithIDGenerator(xray.NewIDGenerator()),
 		sdktrace.WithResource(res),
@@ -42,6 +42,7 @@ const (
 	AwsResource_Queue        AwsResource = "sqs:queue"
 	AwsResource_Bucket       AwsResource = "s3:bucket"
 	AwsResource_Secret       AwsResource = "secretsmanager:secret"
+	AwsResource_Schedule     AwsResource = "events:rule"
 )
 
 var resourceTypeMap = map[common.ResourceType]AwsResource{
@@ -18,19 +18,24 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-lambda-go/lambdacontext"
 	"go.opentelemetry.io/contrib/propagators/aws/xray"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/nitrictech/nitric/cloud/aws/runtime/core"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	ep "github.com/nitrictech/nitric/core/pkg/plugins/events"
 	"github.com/nitrictech/nitric/core/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/core/pkg/triggers"
@@ -43,16 +48,34 @@ const (
 	unknown eventType = iota
 	sns
 	httpEvent
+	websocketEvent
+	scheduledEvent
 	healthcheck
 	xforwardHeader string = "x-forwarded-for"
 )
 
+// The fields of the input EventBridge rules and schedules targeting the lambda may set to identify the nitric schedule
+const (
+	scheduleKeyField     = "x-nitric-schedule"
+	scheduleCadenceField = "x-nitric-schedule-cadence"
+	scheduledTimeField   = "x-nitric-scheduled-time"
+)
+
 type LambdaRuntimeHandler func(handler interface{})
 
 func getEventType(request map[string]interface{}) eventType {
-	// If our event is a HTTP request
-	if _, ok := request["rawPath"]; ok {
+	// If our event is the firing of a schedule
+	if _, ok := request[scheduleKeyField]; ok {
+		return scheduledEvent
+	} else if request["source"] == "aws.events" && request["detail-type"] == "Scheduled Event" {
+		// EventBridge rules without an input send the scheduled event itself
+		return scheduledEvent
+	} else if _, ok := request["rawPath"]; ok {
+		// If our event is a HTTP request
 		return httpEvent
+	} else if rc, ok := request["requestContext"].(map[string]interface{}); ok && rc["connectionId"] != nil {
+		// API Gateway websocket events identify the connection they occurred on
+		return websocketEvent
 	} else if records, ok := request["Records"]; ok {
 		recordsList, _ := records.([]interface{})
 		record, _ := recordsList[0].(map[string]interface{})
@@ -89,6 +112,84 @@ func (s *LambdaGateway) getTopicNameForArn(ctx context.Context, topicArn string)
 	return "", fmt.Errorf("could not find topic for arn %s", topicArn)
 }
 
+// getScheduleNameForArn - resolves the nitric schedule key from the ARN of the EventBridge rule that fired it
+func (s *LambdaGateway) getScheduleNameForArn(ctx context.Context, ruleArn string) (string, error) {
+	schedules, err := s.provider.GetResources(ctx, core.AwsResource_Schedule)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving schedules: %w", err)
+	}
+
+	for name, arn := range schedules {
+		if arn == ruleArn {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find schedule for arn %s", ruleArn)
+}
+
+// scheduleFromEvent - converts an EventBridge scheduled event, or the input of a rule or schedule identifying the nitric schedule, to a schedule trigger
+func (s *LambdaGateway) scheduleFromEvent(ctx context.Context, data map[string]interface{}) (*triggers.Schedule, error) {
+	now := time.Now()
+
+	if key, ok := data[scheduleKeyField].(string); ok {
+		sched := &triggers.Schedule{
+			Key:           key,
+			ScheduledTime: now,
+			FireTime:      now,
+		}
+		sched.Cadence, _ = data[scheduleCadenceField].(string)
+
+		// e.g. the <aws.scheduler.scheduled-time> of EventBridge Scheduler
+		if scheduledTime, ok := data[scheduledTimeField].(string); ok {
+			t, err := time.Parse(time.RFC3339, scheduledTime)
+			if err != nil {
+				return nil, fmt.Errorf("invalid scheduled time %s: %w", scheduledTime, err)
+			}
+			sched.ScheduledTime = t
+		}
+
+		return sched, nil
+	}
+
+	bytes, _ := json.Marshal(data)
+	evt := &events.CloudWatchEvent{}
+	if err := json.Unmarshal(bytes, evt); err != nil {
+		return nil, fmt.Errorf("invalid scheduled event: %w", err)
+	}
+
+	if len(evt.Resources) == 0 {
+		return nil, fmt.Errorf("scheduled event %s has no rule", evt.ID)
+	}
+
+	key, err := s.getScheduleNameForArn(ctx, evt.Resources[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &triggers.Schedule{
+		Key:           key,
+		ScheduledTime: evt.Time,
+		FireTime:      now,
+	}, nil
+}
+
+// getApiNameForId - resolves the nitric API name from an API Gateway API ID, which is the final segment of the API's ARN
+func (s *LambdaGateway) getApiNameForId(ctx context.Context, apiId string) (string, error) {
+	apis, err := s.provider.GetResources(ctx, core.AwsResource_Api)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving apis: %w", err)
+	}
+
+	for name, arn := range apis {
+		if strings.HasSuffix(arn, "/"+apiId) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find api for id %s", apiId)
+}
+
 func (s *LambdaGateway) isHealthCheck(data map[string]interface{}) bool {
 	_, ok := data["x-nitric-healthcheck"]
 
@@ -108,12 +209,16 @@ func (s *LambdaGateway) triggersFromRequest(ctx context.Context, data map[string
 				// FIXME: What about non-nitric SNS events???
 				messageJson := &ep.NitricEvent{}
 				var payloadBytes []byte
-				var id string
+				var id, payloadType string
 				attrs := map[string]string{}
 
 				for k, v := range snsRecord.SNS.MessageAttributes {
-					sv, ok := v.(string)
-					if ok {
+					// Attributes are delivered as {"Type": "String", "Value": "..."}
+					if av, ok := v.(map[string]interface{}); ok {
+						if sv, ok := av["Value"].(string); ok {
+							attrs[k] = sv
+						}
+					} else if sv, ok := v.(string); ok {
 						attrs[k] = sv
 					}
 				}
@@ -122,6 +227,7 @@ func (s *LambdaGateway) triggersFromRequest(ctx context.Context, data map[string
 				if err := json.Unmarshal([]byte(messageString), messageJson); err == nil {
 					payloadMap := messageJson.Payload
 					id = messageJson.ID
+					payloadType = messageJson.PayloadType
 					payloadBytes, _ = json.Marshal(&payloadMap)
 				} else {
 					// just try to capture the raw message
@@ -132,14 +238,17 @@ func (s *LambdaGateway) triggersFromRequest(ctx context.Context, data map[string
 				tName, err := s.getTopicNameForArn(ctx, snsRecord.SNS.TopicArn)
 
 				if err == nil {
+					// SNS does not report delivery attempts to lambda
 					trigs = append(trigs, &triggers.Event{
-						ID:         id,
-						Topic:      tName,
-						Payload:    payloadBytes,
-						Attributes: attrs,
+						ID:          id,
+						Topic:       tName,
+						PayloadType: payloadType,
+						Payload:     payloadBytes,
+						Attributes:  attrs,
+						PublishTime: snsRecord.SNS.Timestamp,
 					})
 				} else {
-					log.Default().Printf("unable to find nitric topic: %v", err)
+					logger.WithError(logger.FromContext(ctx), err).Warn("unable to find nitric topic")
 				}
 			}
 		}
@@ -171,6 +280,15 @@ func (s *LambdaGateway) triggersFromRequest(ctx context.Context, data map[string
 			return nil, fmt.Errorf("error parsing query for httpEvent: %w", err)
 		}
 
+		// Tag the request with its API so it only matches that API's routes
+		apiName := ""
+		if evt.RequestContext.APIID != "" {
+			apiName, err = s.getApiNameForId(ctx, evt.RequestContext.APIID)
+			if err != nil {
+				logger.WithError(logger.FromContext(ctx), err).Warn("unable to find nitric api")
+			}
+		}
+
 		trigs = append(trigs, &triggers.HttpRequest{
 			// FIXME: Translate to http.Header
 			Header: headerCopy,
@@ -179,8 +297,59 @@ func (s *LambdaGateway) triggersFromRequest(ctx context.Context, data map[string
 			Path:   evt.RawPath,
 			URL:    evt.RawPath,
 			Query:  qVals,
+			Api:    apiName,
 		})
 
+	case websocketEvent:
+		evt := &events.APIGatewayWebsocketProxyRequest{}
+
+		err := json.Unmarshal(bytes, evt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal websocketEvent: %w", err)
+		}
+
+		var wsEvent triggers.WebsocketEvent
+		switch evt.RequestContext.EventType {
+		case "CONNECT":
+			wsEvent = triggers.WebsocketEvent_Connect
+		case "DISCONNECT":
+			wsEvent = triggers.WebsocketEvent_Disconnect
+		case "MESSAGE":
+			wsEvent = triggers.WebsocketEvent_Message
+		default:
+			return nil, fmt.Errorf("unhandled websocket event type %s", evt.RequestContext.EventType)
+		}
+
+		// Websocket APIs are tagged with the name of their socket
+		socket, err := s.getApiNameForId(ctx, evt.RequestContext.APIID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find nitric socket: %w", err)
+		}
+
+		body := []byte(evt.Body)
+		if evt.IsBase64Encoded {
+			body, err = base64.StdEncoding.DecodeString(evt.Body)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding websocket message body: %w", err)
+			}
+		}
+
+		trigs = append(trigs, &triggers.WebsocketMessage{
+			Socket:       socket,
+			Event:        wsEvent,
+			ConnectionID: evt.RequestContext.ConnectionID,
+			Query:        evt.MultiValueQueryStringParameters,
+			Body:         body,
+		})
+
+	case scheduledEvent:
+		sched, err := s.scheduleFromEvent(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+
+		trigs = append(trigs, sched)
+
 	case healthcheck:
 
 	default:
@@ -217,14 +386,42 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 				wrkr, err := s.pool.GetWorker(&worker.GetWorkerOptions{
 					Http: httpEvent,
 				})
-				if err != nil {
+
+				var recoveringErr *worker.RecoveringError
+				if errors.As(err, &recoveringErr) {
+					return events.APIGatewayProxyResponse{
+						StatusCode: 503,
+						Headers: map[string]string{
+							"Retry-After": strconv.Itoa(recoveringErr.RetryAfterSeconds()),
+						},
+						Body: "Service Unavailable",
+					}, nil
+				} else if errors.Is(err, worker.ErrDraining) {
+					// The workers for the route are leaving the pool, the client may retry shortly
+					return events.APIGatewayProxyResponse{
+						StatusCode: 503,
+						Headers: map[string]string{
+							"Retry-After": "1",
+						},
+						Body: "Service Unavailable",
+					}, nil
+				} else if err != nil {
 					return nil, fmt.Errorf("unable to get worker to handle http trigger")
 				}
 
 				var hc propagation.HeaderCarrier = httpEvent.Header
 
 				response, err := wrkr.HandleHttpRequest(xray.Propagator{}.Extract(ctx, hc), httpEvent)
-				if err != nil {
+				if errors.Is(err, worker.ErrOverloaded) || errors.Is(err, worker.ErrDraining) {
+					// The worker is at its maximum concurrency or leaving the pool, the client may retry shortly
+					return events.APIGatewayProxyResponse{
+						StatusCode: 503,
+						Headers: map[string]string{
+							"Retry-After": "1",
+						},
+						Body: "Service Unavailable",
+					}, nil
+				} else if err != nil {
 					return events.APIGatewayProxyResponse{
 						StatusCode: 500,
 						Body:       "Error processing lambda request",
@@ -241,7 +438,16 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 					})
 				}
 
-				responseString := base64.StdEncoding.EncodeToString(response.Body)
+				body := response.Body
+				if response.BodyStream != nil {
+					// Lambda responses can't be streamed, so buffer the full body
+					body, err = io.ReadAll(response.BodyStream)
+					if err != nil {
+						return nil, fmt.Errorf("error reading response body: %w", err)
+					}
+				}
+
+				responseString := base64.StdEncoding.EncodeToString(body)
 
 				// We want to sniff the content type of the body that we have here as lambda cannot gzip it...
 				return events.APIGatewayProxyResponse{
@@ -256,33 +462,75 @@ func (s *LambdaGateway) handle(ctx context.Context, data map[string]interface{})
 			}
 		case triggers.TriggerType_Subscription:
 			if event, ok := request.(*triggers.Event); ok {
-				wrkr, err := s.pool.GetWorker(&worker.GetWorkerOptions{
-					Event: event,
-				})
-				if err != nil {
-					return nil, fmt.Errorf("unable to get worker to event trigger")
-				}
-
 				var mc propagation.MapCarrier = event.Attributes
 
-				if err := wrkr.HandleEvent(xray.Propagator{}.Extract(ctx, mc), event); err != nil {
+				if err := worker.DispatchEvent(xray.Propagator{}.Extract(ctx, mc), s.pool, event); err != nil {
 					return nil, err
 				}
 			} else {
 				return nil, fmt.Errorf("found non Event in event with trigger type: %s", triggers.TriggerType_Subscription.String())
 			}
+		case triggers.TriggerType_Websocket:
+			if msg, ok := request.(*triggers.WebsocketMessage); ok {
+				return s.handleWebsocketMessage(ctx, msg), nil
+			} else {
+				return nil, fmt.Errorf("found non WebsocketMessage in event with trigger type: %s", triggers.TriggerType_Websocket.String())
+			}
+		case triggers.TriggerType_Schedule:
+			if sched, ok := request.(*triggers.Schedule); ok {
+				// Failed schedules are returned as errors, so EventBridge retries the invocation
+				if err := worker.DispatchSchedule(ctx, s.pool, sched); err != nil {
+					return nil, err
+				}
+			} else {
+				return nil, fmt.Errorf("found non Schedule in event with trigger type: %s", triggers.TriggerType_Schedule.String())
+			}
 		}
 	}
 
 	return nil, nil
 }
 
+// handleWebsocketMessage - relays a websocket event to its worker, the response status of a connect event determines whether API Gateway accepts the connection.
+//
+// Sending to connections requires the API Gateway management API, so the lambda gateway does not implement the WebsocketService.
+func (s *LambdaGateway) handleWebsocketMessage(ctx context.Context, msg *triggers.WebsocketMessage) events.APIGatewayProxyResponse {
+	handlers := s.pool.GetWorkers(&worker.GetWorkerOptions{
+		Websocket: msg,
+	})
+
+	// Accept connections to sockets that don't handle the connect event
+	if len(handlers) == 0 && msg.Event == triggers.WebsocketEvent_Connect {
+		return events.APIGatewayProxyResponse{StatusCode: 200}
+	}
+
+	if err := worker.DispatchWebsocketMessage(ctx, s.pool, msg); err != nil {
+		logger.WithError(logger.FromContext(ctx), err).WithFields(logger.Fields{
+			"event":         msg.Event,
+			"connection_id": msg.ConnectionID,
+		}).Error("error handling websocket event")
+
+		if msg.Event == triggers.WebsocketEvent_Connect {
+			return events.APIGatewayProxyResponse{StatusCode: 403}
+		}
+
+		return events.APIGatewayProxyResponse{StatusCode: 500}
+	}
+
+	return events.APIGatewayProxyResponse{StatusCode: 200}
+}
+
 // Start the lambda gateway handler
 func (s *LambdaGateway) Start(pool worker.WorkerPool) error {
 	// s.finished = make(chan int)
 	s.pool = pool
 	// Here we want to begin polling lambda for incoming requests...
 	s.runtime(func(ctx context.Context, data map[string]interface{}) (interface{}, error) {
+		if lc, ok := lambdacontext.FromContext(ctx); ok {
+			// Correlate the lines logged while handling this invocation
+			ctx = logger.WithFields(ctx, logger.Fields{logger.RequestIDKey: lc.AwsRequestID})
+		}
+
 		a, err := s.handle(ctx, data)
 
 		tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
@@ -300,7 +548,7 @@ func (s *LambdaGateway) Start(pool worker.WorkerPool) error {
 func (s *LambdaGateway) Stop() error {
 	// XXX: This is a NO_OP Process, as this is a pull based system
 	// We don't need to stop listening to anything
-	log.Default().Println("gateway 'Stop' called, waiting for lambda runtime to finish")
+	logger.Default().Info("gateway 'Stop' called, waiting for lambda runtime to finish")
 	// Lambda can't be stopped, need to wait for it to finish
 	<-s.finished
 	return nil
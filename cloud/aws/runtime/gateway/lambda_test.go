@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/golang/mock/gomock"
@@ -57,6 +58,42 @@ func (m *MockLambdaRuntime) Start(handler interface{}) {
 	}
 }
 
+type mockWebsocketAdapter struct {
+	worker.UnimplementedWorker
+	received []*triggers.WebsocketMessage
+}
+
+func (m *mockWebsocketAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return m.UnimplementedWorker.HandleEvent(trigger)
+}
+
+func (m *mockWebsocketAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return m.UnimplementedWorker.HandleHttpRequest(trigger)
+}
+
+func (m *mockWebsocketAdapter) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	m.received = append(m.received, trigger)
+	return nil
+}
+
+type mockScheduleAdapter struct {
+	worker.UnimplementedWorker
+	received []*triggers.Schedule
+}
+
+func (m *mockScheduleAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return m.UnimplementedWorker.HandleEvent(trigger)
+}
+
+func (m *mockScheduleAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return m.UnimplementedWorker.HandleHttpRequest(trigger)
+}
+
+func (m *mockScheduleAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	m.received = append(m.received, trigger)
+	return nil
+}
+
 var _ = Describe("Lambda", func() {
 	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
 
@@ -91,6 +128,7 @@ var _ = Describe("Lambda", func() {
 					RawQueryString: "key=test&key2=test1&key=test2",
 					Body:           "Test Payload",
 					RequestContext: events.APIGatewayV2HTTPRequestContext{
+						APIID: "abc123",
 						HTTP: events.APIGatewayV2HTTPRequestContextHTTPDescription{
 							Method: "GET",
 						},
@@ -99,6 +137,10 @@ var _ = Describe("Lambda", func() {
 				}},
 			}
 
+			mockProvider.EXPECT().GetResources(gomock.Any(), core.AwsResource_Api).Return(map[string]string{
+				"main": "arn:aws:apigateway:us-east-1::/apis/abc123",
+			}, nil)
+
 			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
 			Expect(err).To(BeNil())
 
@@ -126,6 +168,8 @@ var _ = Describe("Lambda", func() {
 				Expect(request.Method).To(Equal("GET"))
 				By("Retaining the path")
 				Expect(request.Path).To(Equal("/test/test"))
+				By("Tagging the api")
+				Expect(request.Api).To(Equal("main"))
 
 				By("Retaining the query parameters")
 				Expect(request.Query["key"]).To(BeEquivalentTo([]string{"test", "test2"}))
@@ -163,8 +207,15 @@ var _ = Describe("Lambda", func() {
 							EventSource:          "aws:sns",
 							EventSubscriptionArn: "some:arbitrary:subscription:arn:MySubscription",
 							SNS: events.SNSEntity{
-								TopicArn: fmt.Sprintf("some:arbitrary:topic:arn:%s", topicName),
-								Message:  string(messageBytes),
+								TopicArn:  fmt.Sprintf("some:arbitrary:topic:arn:%s", topicName),
+								Message:   string(messageBytes),
+								Timestamp: time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC),
+								MessageAttributes: map[string]interface{}{
+									"region": map[string]interface{}{
+										"Type":  "String",
+										"Value": "eu",
+									},
+								},
 							},
 						},
 					},
@@ -193,6 +244,136 @@ var _ = Describe("Lambda", func() {
 
 				By("Containing the Source Topic")
 				Expect(request.Topic).To(Equal("MyTopic"))
+
+				By("Containing the event metadata")
+				Expect(request.ID).To(Equal("test-request-id"))
+				Expect(request.PayloadType).To(Equal("test-payload"))
+				Expect(request.Attributes).To(HaveKeyWithValue("region", "eu"))
+				Expect(request.PublishTime).To(Equal(time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC)))
+			})
+		})
+	})
+
+	Context("Websocket Events", func() {
+		When("The Lambda Gateway receives websocket events", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			wsAdapter := &mockWebsocketAdapter{}
+			err := pool.AddWorker(worker.NewWebsocketWorker(wsAdapter, &worker.WebsocketWorkerOptions{
+				Socket: "chat",
+				Event:  triggers.WebsocketEvent_Message,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+
+			runtime := MockLambdaRuntime{
+				// Setup mock events for our runtime to process...
+				eventQueue: []interface{}{
+					&events.APIGatewayWebsocketProxyRequest{
+						RequestContext: events.APIGatewayWebsocketProxyRequestContext{
+							APIID:        "ws123",
+							ConnectionID: "conn-1",
+							EventType:    "CONNECT",
+						},
+						MultiValueQueryStringParameters: map[string][]string{
+							"room": {"general"},
+						},
+					},
+					&events.APIGatewayWebsocketProxyRequest{
+						RequestContext: events.APIGatewayWebsocketProxyRequestContext{
+							APIID:        "ws123",
+							ConnectionID: "conn-1",
+							EventType:    "MESSAGE",
+						},
+						Body:            "aGVsbG8=",
+						IsBase64Encoded: true,
+					},
+				},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should translate into websocket messages", func() {
+				By("having the socket api available")
+				mockProvider.EXPECT().GetResources(gomock.Any(), core.AwsResource_Api).Return(map[string]string{
+					"chat": "arn:aws:apigateway:us-east-1::/apis/ws123",
+				}, nil).AnyTimes()
+
+				err := client.Start(pool)
+				Expect(err).To(BeNil())
+
+				By("Handling the message, accepting the connection without a connect handler")
+				Expect(wsAdapter.received).To(HaveLen(1))
+
+				msg := wsAdapter.received[0]
+
+				By("Resolving the socket name")
+				Expect(msg.Socket).To(Equal("chat"))
+				By("Retaining the connection ID")
+				Expect(msg.ConnectionID).To(Equal("conn-1"))
+				By("Decoding the body")
+				Expect(string(msg.Body)).To(Equal("hello"))
+			})
+		})
+	})
+
+	Context("Scheduled Events", func() {
+		When("The Lambda Gateway receives events from EventBridge", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockProvider := mock_provider.NewMockAwsProvider(ctrl)
+
+			schedulePool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+			scheduleAdapter := &mockScheduleAdapter{}
+			err := schedulePool.AddWorker(worker.NewScheduleWorker(scheduleAdapter, &worker.ScheduleWorkerOptions{
+				Key:  "Prune Orders",
+				Rate: "5 minutes",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+
+			scheduledTime := time.Date(2022, time.January, 3, 10, 5, 0, 0, time.UTC)
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{
+					&events.CloudWatchEvent{
+						ID:         "event-1",
+						DetailType: "Scheduled Event",
+						Source:     "aws.events",
+						Time:       scheduledTime,
+						Resources:  []string{"arn:aws:events:us-east-1:123456789012:rule/prune-orders"},
+					},
+					map[string]interface{}{
+						"x-nitric-schedule":       "Prune Orders",
+						"x-nitric-scheduled-time": "2022-01-03T10:10:00Z",
+					},
+				},
+			}
+
+			client, err := lambda_service.NewWithRuntime(mockProvider, runtime.Start)
+			Expect(err).To(BeNil())
+
+			It("The gateway should translate into schedule triggers", func() {
+				By("having the schedule rule available")
+				mockProvider.EXPECT().GetResources(gomock.Any(), core.AwsResource_Schedule).Return(map[string]string{
+					"Prune Orders": "arn:aws:events:us-east-1:123456789012:rule/prune-orders",
+				}, nil)
+
+				err := client.Start(schedulePool)
+				Expect(err).To(BeNil())
+
+				By("Handling both firings as schedules")
+				Expect(scheduleAdapter.received).To(HaveLen(2))
+
+				By("Retaining the original schedule key")
+				Expect(scheduleAdapter.received[0].Key).To(Equal("Prune Orders"))
+				Expect(scheduleAdapter.received[1].Key).To(Equal("Prune Orders"))
+
+				By("Using the scheduled time of the event")
+				Expect(scheduleAdapter.received[0].ScheduledTime).To(Equal(scheduledTime))
+				Expect(scheduleAdapter.received[1].ScheduledTime).To(Equal(scheduledTime.Add(5 * time.Minute)))
+
+				By("Including the cadence of the schedule")
+				Expect(scheduleAdapter.received[0].Cadence).To(Equal("5 minutes"))
 			})
 		})
 	})
@@ -15,8 +15,6 @@
 package main
 
 import (
-	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"syscall"
@@ -29,6 +27,8 @@ import (
 	http_service "github.com/nitrictech/nitric/cloud/azure/runtime/gateway"
 	key_vault "github.com/nitrictech/nitric/cloud/azure/runtime/secret"
 	azblob_service "github.com/nitrictech/nitric/cloud/azure/runtime/storage"
+	local_events "github.com/nitrictech/nitric/cloud/common/runtime/events"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/membrane"
 )
 
@@ -40,19 +40,23 @@ func main() {
 
 	provider, err := core.New()
 	if err != nil {
-		log.Fatalf("could not create core azure provider: %v", err)
+		logger.Default().WithError(err).Fatal("could not create core azure provider")
 	}
 
 	membraneOpts := membrane.DefaultMembraneOptions()
 
 	membraneOpts.DocumentPlugin, err = mongodb_service.New()
 	if err != nil {
-		log.Default().Println("Failed to load document plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load document plugin")
 	}
 
-	membraneOpts.EventsPlugin, err = event_grid.New(provider)
-	if err != nil {
-		log.Default().Println("Failed to load event plugin:", err.Error())
+	if local_events.Enabled() {
+		membraneOpts.CreateEventsPlugin = local_events.New
+	} else {
+		membraneOpts.EventsPlugin, err = event_grid.New(provider)
+		if err != nil {
+			logger.Default().WithError(err).Warn("failed to load event plugin")
+		}
 	}
 	membraneOpts.GatewayPlugin, _ = http_service.New(provider)
 	membraneOpts.QueuePlugin, _ = azqueue_service.New()
@@ -60,12 +64,12 @@ func main() {
 	membraneOpts.SecretPlugin, err = key_vault.New()
 	membraneOpts.ResourcesPlugin = provider
 	if err != nil {
-		log.Default().Println("Failed to load secret plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load secret plugin")
 	}
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
-		log.Fatalf("There was an error initialising the membrane server: %v", err)
+		logger.Default().WithError(err).Fatal("could not initialise the membrane server")
 	}
 
 	errChan := make(chan error)
@@ -76,9 +80,9 @@ func main() {
 
 	select {
 	case membraneError := <-errChan:
-		fmt.Printf("Membrane Error: %v, exiting\n", membraneError)
+		logger.Default().WithError(membraneError).Error("membrane error, exiting")
 	case sigTerm := <-term:
-		fmt.Printf("Received %v, exiting\n", sigTerm)
+		logger.Default().WithField("signal", sigTerm).Info("received signal, exiting")
 	}
 
 	m.Stop()
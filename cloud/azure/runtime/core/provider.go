@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/providers/common"
 )
 
@@ -154,7 +155,12 @@ func (p *azProviderImpl) ServicePrincipalToken(resource string) (*adal.ServicePr
 		userPass.Resource = resource
 		return userPass.ServicePrincipalToken()
 	} else {
-		fmt.Printf("error retrieving credentials:\n -> %v\n -> %v\n -> %v\n -> %v\n", fileErr, clientErr, certErr, userErr)
+		logger.Default().WithFields(logger.Fields{
+			"file":        fileErr,
+			"client":      clientErr,
+			"certificate": certErr,
+			"user":        userErr,
+		}).Warn("error retrieving credentials, falling back to managed identity")
 	}
 
 	msiConf := p.env.GetMSI()
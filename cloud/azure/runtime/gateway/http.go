@@ -15,9 +15,10 @@
 package http_service
 
 import (
-	"context"
 	"encoding/json"
-	"log"
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/eventgrid"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/core"
 	base_http "github.com/nitrictech/nitric/cloud/common/runtime/gateway"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/core/pkg/triggers"
 	"github.com/nitrictech/nitric/core/pkg/worker"
@@ -52,8 +54,14 @@ func (a *azMiddleware) handleSubscriptionValidation(ctx *fasthttp.RequestCtx, ev
 }
 
 func (a *azMiddleware) handleNotifications(ctx *fasthttp.RequestCtx, events []eventgrid.Event, pool worker.WorkerPool) {
-	// TODO: As we are batch handling events
-	// how do we notify of failed event handling?
+	failed := 0
+
+	// The number of previous attempts to deliver the events
+	deliveryAttempt := 0
+	if deliveryCount, err := strconv.Atoi(string(ctx.Request.Header.Peek("aeg-delivery-count"))); err == nil {
+		deliveryAttempt = deliveryCount + 1
+	}
+
 	for _, event := range events {
 		// XXX: Assume we have a nitric event for now
 		// We have a valid nitric event
@@ -68,10 +76,14 @@ func (a *azMiddleware) handleNotifications(ctx *fasthttp.RequestCtx, events []ev
 			payloadBytes, _ = json.Marshal(event.Data)
 		}
 
+		// Event Grid event IDs are unique, so they identify the request handling them
+		evtCtx := logger.WithFields(base_http.RequestContext(ctx), logger.Fields{logger.RequestIDKey: *event.ID})
+		log := logger.FromContext(evtCtx)
+
 		var evt *triggers.Event
-		topics, err := a.provider.GetResources(context.TODO(), core.AzResource_Topic)
+		topics, err := a.provider.GetResources(evtCtx, core.AzResource_Topic)
 		if err != nil {
-			log.Default().Println("could not get topic resources")
+			logger.WithError(log, err).Error("could not get topic resources")
 			continue
 		}
 
@@ -83,41 +95,69 @@ func (a *azMiddleware) handleNotifications(ctx *fasthttp.RequestCtx, events []ev
 		}
 
 		if topicName == "" {
-			log.Default().Println("could not resolve nitric name for topic", *event.Topic)
+			log.WithField("topic", *event.Topic).Warn("could not resolve nitric name for topic")
 			continue
 		}
 
 		// Just extract the payload from the event type (payload from nitric event is directly mapped)
 		evt = &triggers.Event{
-			ID:         *event.ID,
-			Topic:      topicName,
-			Payload:    payloadBytes,
-			Attributes: map[string]string{},
+			ID:              *event.ID,
+			Topic:           topicName,
+			Payload:         payloadBytes,
+			Attributes:      map[string]string{},
+			DeliveryAttempt: deliveryAttempt,
 		}
 
-		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
-			Event: evt,
-		})
-		if err != nil {
-			log.Default().Println("could not get worker for topic: ", topicName)
-			// TODO: Handle error
-			continue
+		// The payload type of nitric events is published as the event type
+		if event.EventType != nil {
+			evt.PayloadType = *event.EventType
 		}
 
-		err = wrkr.HandleEvent(context.TODO(), evt)
-		if err != nil {
-			log.Default().Println("could not handle event: ", evt)
-			// TODO: Handle error
-			continue
+		if event.EventTime != nil {
+			evt.PublishTime = event.EventTime.Time
+		}
+
+		if err := worker.DispatchEvent(evtCtx, pool, evt); err != nil {
+			logger.WithError(log, err).Error("could not handle event")
+			failed++
 		}
 	}
 
-	// Return 200 OK (TODO: Determine how we could mark individual events for failure)
-	// Or potentially requeue them here internally...
+	// Event Grid retries the whole batch, so fail the request if any event could not be delivered
+	if failed > 0 {
+		ctx.Error(fmt.Sprintf("failed to handle %d of %d events", failed, len(events)), 500)
+		return
+	}
+
 	ctx.SuccessString("text/plain", "success")
 }
 
+// schedulePathPrefix - the path schedulers post to when firing a schedule, followed by the escaped schedule key,
+// e.g. the route of a Dapr cron binding or the uri of a Logic App recurrence
+const schedulePathPrefix = "/x-nitric-schedule/"
+
+func (a *azMiddleware) handleSchedule(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) {
+	key, err := url.PathUnescape(strings.TrimPrefix(string(ctx.URI().PathOriginal()), schedulePathPrefix))
+	if err != nil || key == "" {
+		ctx.Error("Invalid schedule", 400)
+		return
+	}
+
+	sched, err := base_http.ScheduleFromRequest(ctx, key)
+	if err != nil {
+		ctx.Error(err.Error(), 400)
+		return
+	}
+
+	base_http.HandleSchedule(ctx, pool, sched)
+}
+
 func (a *azMiddleware) middleware(ctx *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
+	if ctx.IsPost() && strings.HasPrefix(string(ctx.Path()), schedulePathPrefix) {
+		a.handleSchedule(ctx, pool)
+		return false
+	}
+
 	eventType := string(ctx.Request.Header.Peek("aeg-event-type"))
 
 	// Handle an eventgrid webhook event
@@ -16,6 +16,7 @@ package http_service_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,6 +25,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/eventgrid"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/golang/mock/gomock"
 
 	. "github.com/onsi/ginkgo"
@@ -40,8 +42,27 @@ import (
 
 const GATEWAY_ADDRESS = "127.0.0.1:9001"
 
+// scheduleAdapter - records the schedules fired to it
+type scheduleAdapter struct {
+	worker.UnimplementedWorker
+	received []*triggers.Schedule
+}
+
+func (a *scheduleAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return a.UnimplementedWorker.HandleEvent(trigger)
+}
+
+func (a *scheduleAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return a.UnimplementedWorker.HandleHttpRequest(trigger)
+}
+
+func (a *scheduleAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	a.received = append(a.received, trigger)
+	return nil
+}
+
 var _ = Describe("Http", func() {
-	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 2})
 
 	gatewayUrl := fmt.Sprintf("http://%s", GATEWAY_ADDRESS)
 	// Set this to loopback to ensure its not public in our CI/Testing environments
@@ -58,6 +79,13 @@ var _ = Describe("Http", func() {
 	err := pool.AddWorker(mockHandler)
 	Expect(err).To(BeNil())
 
+	schedules := &scheduleAdapter{}
+	err = pool.AddWorker(worker.NewScheduleWorker(schedules, &worker.ScheduleWorkerOptions{
+		Key:  "Nightly Report",
+		Cron: "0 2 * * *",
+	}))
+	Expect(err).To(BeNil())
+
 	ctrl := gomock.NewController(GinkgoT())
 	provider := mock_provider.NewMockAzProvider(ctrl)
 
@@ -82,6 +110,7 @@ var _ = Describe("Http", func() {
 
 	AfterEach(func() {
 		mockHandler.Reset()
+		schedules.received = nil
 	})
 
 	When("Invoking the Azure AppService HTTP Gateway", func() {
@@ -152,11 +181,15 @@ var _ = Describe("Http", func() {
 				payloadBytes, _ := json.Marshal(payload)
 				testTopic := "test"
 				testID := "1234"
+				testType := "Test Payload"
+				publishTime := time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC)
 				evt := []eventgrid.Event{
 					{
-						ID:    &testID,
-						Topic: &testTopic,
-						Data:  payload,
+						ID:        &testID,
+						Topic:     &testTopic,
+						Data:      payload,
+						EventType: &testType,
+						EventTime: &date.Time{Time: publishTime},
 					},
 				}
 
@@ -165,6 +198,7 @@ var _ = Describe("Http", func() {
 				request, err := http.NewRequest("POST", gatewayUrl, bytes.NewReader(requestBody))
 				Expect(err).To(BeNil())
 				request.Header.Add("aeg-event-type", "Notification")
+				request.Header.Add("aeg-delivery-count", "1")
 				_, _ = http.DefaultClient.Do(request)
 
 				By("Passing the event to the Nitric Application")
@@ -179,7 +213,37 @@ var _ = Describe("Http", func() {
 
 				By("Having the provided payload")
 				Expect(event.Payload).To(BeEquivalentTo(payloadBytes))
+
+				By("Having the event metadata")
+				Expect(event.PayloadType).To(Equal("Test Payload"))
+				Expect(event.PublishTime).To(Equal(publishTime))
+				Expect(event.DeliveryAttempt).To(Equal(2))
 			})
 		})
 	})
+
+	When("Invoked by a scheduler", func() {
+		It("Should handle the schedule successfully", func() {
+			request, err := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-schedule/Nightly%%20Report", gatewayUrl), nil)
+			Expect(err).To(BeNil())
+			request.Header.Add("X-Nitric-Scheduled-Time", "2022-01-04T02:00:00Z")
+			resp, err := http.DefaultClient.Do(request)
+			Expect(err).To(BeNil())
+
+			By("The request returns a successful status")
+			Expect(resp.StatusCode).To(Equal(200))
+
+			By("Handling the request as a schedule, not an http request")
+			Expect(mockHandler.ReceivedRequests).To(BeEmpty())
+			Expect(schedules.received).To(HaveLen(1))
+
+			sched := schedules.received[0]
+
+			By("Retaining the original schedule key")
+			Expect(sched.Key).To(Equal("Nightly Report"))
+
+			By("Using the scheduled time of the request")
+			Expect(sched.ScheduledTime).To(Equal(time.Date(2022, time.January, 4, 2, 0, 0, 0, time.UTC)))
+		})
+	})
 })
@@ -18,7 +18,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 
@@ -28,6 +27,7 @@ import (
 
 	azqueueserviceiface "github.com/nitrictech/nitric/cloud/azure/runtime/queue/iface"
 	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/core/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/core/pkg/plugins/queue"
@@ -238,7 +238,7 @@ const expiryBuffer = 2 * time.Minute
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azqueue.TokenRefresher {
 	return func(credential azqueue.TokenCredential) time.Duration {
 		if err := spt.Refresh(); err != nil {
-			log.Default().Println("Error refreshing token: ", err)
+			logger.Default().WithError(err).WithField(logger.PluginKey, "queue").Error("error refreshing token")
 		} else {
 			tkn := spt.Token()
 			credential.SetToken(tkn.AccessToken)
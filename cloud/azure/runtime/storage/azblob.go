@@ -19,7 +19,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"log"
 	"net/url"
 	"time"
 
@@ -29,6 +28,7 @@ import (
 
 	azblob_service_iface "github.com/nitrictech/nitric/cloud/azure/runtime/storage/iface"
 	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
 	"github.com/nitrictech/nitric/core/pkg/plugins/errors/codes"
 	"github.com/nitrictech/nitric/core/pkg/plugins/storage"
@@ -226,7 +226,7 @@ const expiryBuffer = 2 * time.Minute
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azblob.TokenRefresher {
 	return func(credential azblob.TokenCredential) time.Duration {
 		if err := spt.Refresh(); err != nil {
-			log.Default().Println("Error refreshing token: ", err)
+			logger.Default().WithError(err).WithField(logger.PluginKey, "storage").Error("error refreshing token")
 		} else {
 			tkn := spt.Token()
 			credential.SetToken(tkn.AccessToken)
@@ -19,6 +19,8 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
 )
 
 // GetServicePrincipalToken - Retrieves the service principal token from env
@@ -40,7 +42,12 @@ func GetServicePrincipalToken(resource string) (*adal.ServicePrincipalToken, err
 		userPass.Resource = resource
 		return userPass.ServicePrincipalToken()
 	} else {
-		fmt.Printf("error retrieving credentials:\n -> %v\n -> %v\n -> %v\n -> %v\n", fileErr, clientErr, certErr, userErr)
+		logger.Default().WithFields(logger.Fields{
+			"file":        fileErr,
+			"client":      clientErr,
+			"certificate": certErr,
+			"user":        userErr,
+		}).Warn("error retrieving credentials, falling back to managed identity")
 	}
 
 	msiConf := config.GetMSI()
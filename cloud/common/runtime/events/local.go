@@ -0,0 +1,118 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// An events plugin delivering published events directly to the subscribers in the local worker pool
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/plugins/events"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/utils"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+type LocalEventService struct {
+	events.UnimplementedeventsPlugin
+	pool worker.WorkerPool
+}
+
+var _ events.EventService = &LocalEventService{}
+
+func (s *LocalEventService) dispatch(ctx context.Context, event *triggers.Event) {
+	if err := worker.DispatchEvent(ctx, s.pool, event); err != nil {
+		logger.WithError(logger.FromContext(ctx), err).WithField("event_id", event.ID).Error("error delivering event")
+	}
+}
+
+// Publish - delivers the event to all subscribers of the topic in the background, after the given delay in seconds
+func (s *LocalEventService) Publish(ctx context.Context, topic string, delay int, event *events.NitricEvent) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("error serializing event payload: %w", err)
+	}
+
+	// Events are delivered once, without retries
+	trigger := &triggers.Event{
+		ID:              event.ID,
+		Topic:           topic,
+		PayloadType:     event.PayloadType,
+		Payload:         payload,
+		Attributes:      map[string]string{},
+		PublishTime:     time.Now(),
+		DeliveryAttempt: 1,
+	}
+
+	// Keep the span and log fields of the publisher without inheriting its cancellation
+	dispatchCtx := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	dispatchCtx = logger.WithContext(dispatchCtx, logger.FromContext(ctx))
+
+	if delay > 0 {
+		time.AfterFunc(time.Duration(delay)*time.Second, func() {
+			s.dispatch(dispatchCtx, trigger)
+		})
+	} else {
+		go s.dispatch(dispatchCtx, trigger)
+	}
+
+	return nil
+}
+
+// ListTopics - lists the topics subscribed to by workers in the pool
+func (s *LocalEventService) ListTopics(ctx context.Context) ([]string, error) {
+	subscribers := s.pool.GetWorkers(&worker.GetWorkerOptions{
+		Filter: func(w worker.Worker) bool {
+			_, ok := worker.BaseWorker(w).(*worker.SubscriptionWorker)
+			return ok
+		},
+	})
+
+	unique := make(map[string]bool)
+	for _, w := range subscribers {
+		unique[worker.BaseWorker(w).(*worker.SubscriptionWorker).Topic()] = true
+	}
+
+	topics := make([]string, 0, len(unique))
+	for topic := range unique {
+		topics = append(topics, topic)
+	}
+
+	sort.Strings(topics)
+
+	return topics, nil
+}
+
+// Enabled - returns true if the LOCAL_EVENTS env var selects this plugin over the provider's events service,
+// e.g. for local development
+func Enabled() bool {
+	enabled, err := strconv.ParseBool(utils.GetEnv("LOCAL_EVENTS", "false"))
+
+	return err == nil && enabled
+}
+
+// New - Creates a new events plugin publishing to the workers of the given pool
+func New(pool worker.WorkerPool) (events.EventService, error) {
+	return &LocalEventService{
+		pool: pool,
+	}, nil
+}
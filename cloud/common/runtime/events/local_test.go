@@ -0,0 +1,70 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	local_events "github.com/nitrictech/nitric/cloud/common/runtime/events"
+	mock "github.com/nitrictech/nitric/core/mocks/worker"
+	"github.com/nitrictech/nitric/core/pkg/plugins/events"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+var _ = Describe("LocalEventService", func() {
+	When("publishing to a topic with two subscribers", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		first := mock.NewMockAdapter(ctrl)
+		second := mock.NewMockAdapter(ctrl)
+
+		pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 10})
+		Expect(pool.AddWorker(worker.NewSubscriptionWorker(first, &worker.SubscriptionWorkerOptions{Topic: "orders"}))).To(Succeed())
+		Expect(pool.AddWorker(worker.NewSubscriptionWorker(second, &worker.SubscriptionWorkerOptions{Topic: "orders"}))).To(Succeed())
+
+		eventService, err := local_events.New(pool)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		It("should deliver the event to both subscribers", func() {
+			delivered := make(chan *triggers.Event, 2)
+			record := func(ctx context.Context, evt *triggers.Event) error {
+				delivered <- evt
+				return nil
+			}
+
+			first.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).DoAndReturn(record)
+			second.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).DoAndReturn(record)
+
+			err := eventService.Publish(context.TODO(), "orders", 0, &events.NitricEvent{
+				ID:          "1",
+				PayloadType: "order",
+				Payload:     map[string]interface{}{"id": "abc"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := 0; i < 2; i++ {
+				var evt *triggers.Event
+				Eventually(delivered).Should(Receive(&evt))
+				Expect(evt.ID).To(Equal("1"))
+				Expect(evt.Topic).To(Equal("orders"))
+				Expect(string(evt.Payload)).To(MatchJSON(`{"id": "abc"}`))
+			}
+		})
+	})
+})
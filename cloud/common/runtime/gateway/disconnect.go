@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// eofNotifier - closes eof once the reader has been read to its end, or fails
+type eofNotifier struct {
+	io.Reader
+	eof  chan struct{}
+	once sync.Once
+}
+
+func (r *eofNotifier) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err != nil {
+		r.once.Do(func() { close(r.eof) })
+	}
+
+	return n, err
+}
+
+// cancelOnDisconnect - calls cancel if the client closes its connection after the request body has been read.
+// Returns a function that stops watching the connection, which must be called before the handler returns.
+func cancelOnDisconnect(rc *fasthttp.RequestCtx, trigger *triggers.HttpRequest, cancel context.CancelFunc) func() {
+	// The connection can't be watched while the server is still reading the body from it
+	bodyRead := make(chan struct{})
+	if trigger.BodyStream != nil {
+		trigger.BodyStream = &eofNotifier{Reader: trigger.BodyStream, eof: bodyRead}
+	} else {
+		close(bodyRead)
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		select {
+		case <-bodyRead:
+		case <-stop:
+			return
+		}
+
+		if waitForClose(rc.Conn(), stop) {
+			cancel()
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package base_http
+
+import (
+	"net"
+	"syscall"
+	"time"
+)
+
+// waitForClose - waits for the client to close the connection, returning true if it did.
+// Returns false once stop is closed, or if the client sends more data, which is left unread for the server.
+func waitForClose(conn net.Conn, stop <-chan struct{}) bool {
+	sc, ok := conn.(syscall.Conn)
+	if !ok {
+		return false
+	}
+
+	raw, err := sc.SyscallConn()
+	if err != nil {
+		return false
+	}
+
+	readDone := make(chan struct{})
+	// Whether the read was interrupted with a deadline, which must be cleared before the server reads the connection again
+	interrupted := make(chan bool, 1)
+
+	go func() {
+		select {
+		case <-stop:
+			_ = conn.SetReadDeadline(time.Unix(1, 0))
+			interrupted <- true
+		case <-readDone:
+			interrupted <- false
+		}
+	}()
+
+	closed := false
+	buf := make([]byte, 1)
+
+	_ = raw.Read(func(fd uintptr) bool {
+		// Peek, so data sent by the client remains for the server to read
+		n, _, err := syscall.Recvfrom(int(fd), buf, syscall.MSG_PEEK|syscall.MSG_DONTWAIT)
+		if err == syscall.EAGAIN || err == syscall.EINTR {
+			// Wait for the connection to become readable
+			return false
+		}
+
+		closed = n == 0 || err != nil
+
+		return true
+	})
+
+	close(readDone)
+	if <-interrupted {
+		_ = conn.SetReadDeadline(time.Time{})
+	}
+
+	return closed
+}
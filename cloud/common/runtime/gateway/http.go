@@ -17,13 +17,18 @@ package base_http
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/fasthttp/websocket"
 	"github.com/valyala/fasthttp"
 	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/core/pkg/span"
 	"github.com/nitrictech/nitric/core/pkg/triggers"
@@ -33,11 +38,29 @@ import (
 
 type HttpMiddleware func(*fasthttp.RequestCtx, worker.WorkerPool) bool
 
+// ApiRouting - How the gateway determines which API a request was received on
+type ApiRouting = string
+
+const (
+	// ApiRouting_None - requests are not tagged with an API and may match routes of any API
+	ApiRouting_None ApiRouting = ""
+	// ApiRouting_Host - the API is the first label of the request host, e.g. {api}.example.com
+	ApiRouting_Host ApiRouting = "host"
+	// ApiRouting_Prefix - the API is the first segment of the request path, e.g. /{api}/path
+	ApiRouting_Prefix ApiRouting = "prefix"
+)
+
 type BaseHttpGateway struct {
 	address string
+	routing ApiRouting
+	// Maximum time to wait for a worker to respond, no timeout is applied if zero
+	timeout time.Duration
 	server  *fasthttp.Server
 	gateway.UnimplementedGatewayPlugin
 
+	upgrader   *websocket.FastHTTPUpgrader
+	websockets *websocketConnections
+
 	// Middleware for handling events
 	// return bool will indicate whether to continue
 	// to the next (default) behaviour or not...
@@ -46,6 +69,10 @@ type BaseHttpGateway struct {
 
 func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp.RequestCtx) {
 	return func(rc *fasthttp.RequestCtx) {
+		reqCtx, cancel := s.requestContext()
+		defer cancel()
+		rc.SetUserValue(requestContextKey, reqCtx)
+
 		if s.mw != nil {
 			if !s.mw(rc, pool) {
 				// middleware has indicated that is has processed the request
@@ -54,18 +81,58 @@ func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp
 			}
 		}
 
-		httpTrigger := triggers.FromHttpRequest(rc)
+		if websocket.FastHTTPIsWebSocketUpgrade(rc) {
+			s.handleWebsocket(rc, pool)
+			return
+		}
+
+		httpTrigger := triggers.FromStreamingHttpRequest(rc)
+		s.tagApi(rc, httpTrigger)
 
 		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
 			Http: httpTrigger,
 		})
-		if err != nil {
+
+		var recoveringErr *worker.RecoveringError
+		if errors.As(err, &recoveringErr) {
+			rc.Response.Header.Set("Retry-After", strconv.Itoa(recoveringErr.RetryAfterSeconds()))
+			rc.Error("Service Unavailable", 503)
+			return
+		} else if errors.Is(err, worker.ErrDraining) {
+			// The workers for the route are leaving the pool, the client may retry shortly
+			rc.Response.Header.Set("Retry-After", "1")
+			rc.Error("Service Unavailable", 503)
+			return
+		} else if errors.Is(err, worker.ErrRouteNotFound) {
+			rc.Error("Not Found", 404)
+			return
+		} else if errors.Is(err, worker.ErrMethodNotAllowed) {
+			rc.Error("Method Not Allowed", 405)
+			return
+		} else if err != nil {
 			rc.Error("Unable to get worker to handle request", 500)
 			return
 		}
 
-		response, err := wrkr.HandleHttpRequest(span.FromHeaders(context.TODO(), httpTrigger.Header), httpTrigger)
-		if err != nil {
+		// The worker is asked to abort the request if the client goes away
+		stopWatching := cancelOnDisconnect(rc, httpTrigger, cancel)
+		defer stopWatching()
+
+		ctx := logger.WithFields(reqCtx, logger.Fields{logger.RequestIDKey: requestId(rc)})
+		ctx = span.FromHeaders(ctx, httpTrigger.Header)
+
+		response, err := wrkr.HandleHttpRequest(ctx, httpTrigger)
+		if errors.Is(err, worker.ErrOverloaded) || errors.Is(err, worker.ErrDraining) {
+			// The worker is at its maximum concurrency or leaving the pool, the client may retry shortly
+			rc.Response.Header.Set("Retry-After", "1")
+			rc.Error("Service Unavailable", 503)
+			return
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			logger.FromContext(ctx).Warn("http request timed out")
+			rc.Error("Gateway Timeout", 504)
+			return
+		} else if err != nil {
+			logger.WithError(logger.FromContext(ctx), err).Error("error handling http request")
 			rc.Error(fmt.Sprintf("Error handling HTTP Request: %v", err), 500)
 			return
 		}
@@ -77,7 +144,70 @@ func (s *BaseHttpGateway) httpHandler(pool worker.WorkerPool) func(ctx *fasthttp
 		// Avoid content length header duplication
 		rc.Response.Header.Del("Content-Length")
 		rc.Response.SetStatusCode(response.StatusCode)
-		rc.Response.SetBody(response.Body)
+		if response.BodyStream != nil {
+			// Stream the body to the client with chunked encoding
+			rc.Response.SetBodyStream(response.BodyStream, -1)
+		} else {
+			rc.Response.SetBody(response.Body)
+		}
+	}
+}
+
+// requestContextKey - the user value of a request holding the context it is handled with
+const requestContextKey = "x-nitric-request-context"
+
+// requestContext - returns a context for handling a request, done once the gateway's request timeout passes
+func (s *BaseHttpGateway) requestContext() (context.Context, context.CancelFunc) {
+	if s.timeout > 0 {
+		return context.WithTimeout(context.Background(), s.timeout)
+	}
+
+	return context.WithCancel(context.Background())
+}
+
+// RequestContext - returns the context the gateway handles the request with, for middleware delivering triggers to workers
+func RequestContext(rc *fasthttp.RequestCtx) context.Context {
+	if ctx, ok := rc.UserValue(requestContextKey).(context.Context); ok {
+		return ctx
+	}
+
+	return context.TODO()
+}
+
+// requestId - the ID of the request from its X-Request-Id header, or a new random ID if it has none
+func requestId(rc *fasthttp.RequestCtx) string {
+	if id := rc.Request.Header.Peek("X-Request-Id"); len(id) > 0 {
+		return string(id)
+	}
+
+	id, err := newId()
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// tagApi - tags the trigger with the API it was received on, according to the gateway's routing mode
+func (s *BaseHttpGateway) tagApi(rc *fasthttp.RequestCtx, trigger *triggers.HttpRequest) {
+	switch s.routing {
+	case ApiRouting_Host:
+		host := string(rc.Host())
+		if i := strings.Index(host, "."); i > 0 {
+			trigger.Api = host[:i]
+		}
+	case ApiRouting_Prefix:
+		segments := strings.SplitN(strings.TrimPrefix(trigger.Path, "/"), "/", 2)
+		if segments[0] == "" {
+			return
+		}
+
+		trigger.Api = segments[0]
+		if len(segments) > 1 {
+			trigger.Path = "/" + segments[1]
+		} else {
+			trigger.Path = "/"
+		}
 	}
 }
 
@@ -87,6 +217,8 @@ func (s *BaseHttpGateway) Start(pool worker.WorkerPool) error {
 		CloseOnShutdown: true,
 		Handler:         s.httpHandler(pool),
 		ReadBufferSize:  8192,
+		// Pass request bodies through to workers as they arrive, rather than buffering them
+		StreamRequestBody: true,
 	}
 
 	return s.server.ListenAndServe(s.address)
@@ -109,9 +241,25 @@ func (s *BaseHttpGateway) Stop() error {
 // XXX: No External Args for function atm (currently the plugin loader does not pass any argument information)
 func New(mw HttpMiddleware) (gateway.GatewayService, error) {
 	address := utils.GetEnv("GATEWAY_ADDRESS", ":9001")
+	routing := utils.GetEnv("GATEWAY_API_ROUTING", ApiRouting_None)
+	if routing != ApiRouting_None && routing != ApiRouting_Host && routing != ApiRouting_Prefix {
+		return nil, fmt.Errorf("invalid GATEWAY_API_ROUTING env var, expected %s or %s, got %v", ApiRouting_Host, ApiRouting_Prefix, routing)
+	}
+
+	timeout, err := time.ParseDuration(utils.GetEnv("GATEWAY_REQUEST_TIMEOUT", "60s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid GATEWAY_REQUEST_TIMEOUT env var, expected a duration such as 30s: %w", err)
+	}
 
 	return &BaseHttpGateway{
 		address: address,
+		routing: routing,
+		timeout: timeout,
 		mw:      mw,
+		upgrader: &websocket.FastHTTPUpgrader{
+			// Websockets are served to clients of any origin, as with http routes
+			CheckOrigin: func(ctx *fasthttp.RequestCtx) bool { return true },
+		},
+		websockets: newWebsocketConnections(),
 	}, nil
 }
@@ -0,0 +1,80 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/span"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// Headers a scheduler may set when firing a schedule over http, to identify the nitric schedule
+const (
+	ScheduleKeyHeader     = "X-Nitric-Schedule"
+	ScheduleCadenceHeader = "X-Nitric-Schedule-Cadence"
+	ScheduledTimeHeader   = "X-Nitric-Scheduled-Time"
+)
+
+// ScheduleFromRequest - a firing of the schedule with the given key, taking its cadence and scheduled time from the request headers if set
+func ScheduleFromRequest(rc *fasthttp.RequestCtx, key string) (*triggers.Schedule, error) {
+	now := time.Now()
+
+	sched := &triggers.Schedule{
+		Key:           key,
+		ScheduledTime: now,
+		FireTime:      now,
+		Cadence:       string(rc.Request.Header.Peek(ScheduleCadenceHeader)),
+	}
+
+	if scheduledTime := rc.Request.Header.Peek(ScheduledTimeHeader); len(scheduledTime) > 0 {
+		t, err := time.Parse(time.RFC3339, string(scheduledTime))
+		if err != nil {
+			return nil, fmt.Errorf("invalid scheduled time %s: %w", scheduledTime, err)
+		}
+		sched.ScheduledTime = t
+	}
+
+	return sched, nil
+}
+
+// HandleSchedule - dispatches the firing of a schedule to its worker, failing the request so the scheduler retries if it could not be handled
+func HandleSchedule(rc *fasthttp.RequestCtx, pool worker.WorkerPool, sched *triggers.Schedule) {
+	ctx := logger.WithFields(RequestContext(rc), logger.Fields{logger.RequestIDKey: requestId(rc)})
+	ctx = span.FromHeaders(ctx, triggers.HttpHeaders(&rc.Request.Header))
+
+	err := worker.DispatchSchedule(ctx, pool, sched)
+
+	var recoveringErr *worker.RecoveringError
+	if errors.As(err, &recoveringErr) {
+		rc.Response.Header.Set("Retry-After", strconv.Itoa(recoveringErr.RetryAfterSeconds()))
+		rc.Error("Service Unavailable", 503)
+	} else if errors.Is(err, worker.ErrOverloaded) || errors.Is(err, worker.ErrDraining) {
+		rc.Response.Header.Set("Retry-After", "1")
+		rc.Error("Service Unavailable", 503)
+	} else if err != nil {
+		logger.WithError(logger.FromContext(ctx), err).WithField("schedule", sched.Key).Error("error handling schedule")
+		rc.Error(fmt.Sprintf("Error handling schedule %v", err), 500)
+	} else {
+		rc.SuccessString("text/plain", "success")
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base_http
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fasthttp/websocket"
+	"github.com/valyala/fasthttp"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// websocketConnection - A client connected to a socket, writes are serialised as websocket connections do not support concurrent writers
+type websocketConnection struct {
+	writeLock sync.Mutex
+	conn      *websocket.Conn
+}
+
+func (c *websocketConnection) write(messageType int, data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// websocketConnections - The clients connected to the gateway, by socket name and connection ID
+type websocketConnections struct {
+	lock  sync.RWMutex
+	conns map[string]map[string]*websocketConnection
+}
+
+func (w *websocketConnections) add(socket string, id string, conn *websocketConnection) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.conns[socket] == nil {
+		w.conns[socket] = make(map[string]*websocketConnection)
+	}
+
+	w.conns[socket][id] = conn
+}
+
+func (w *websocketConnections) remove(socket string, id string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.conns[socket], id)
+}
+
+func (w *websocketConnections) get(socket string, id string) (*websocketConnection, error) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	conn, ok := w.conns[socket][id]
+	if !ok {
+		return nil, fmt.Errorf("connection %s is not connected to socket %s", id, socket)
+	}
+
+	return conn, nil
+}
+
+func newWebsocketConnections() *websocketConnections {
+	return &websocketConnections{
+		conns: make(map[string]map[string]*websocketConnection),
+	}
+}
+
+// newId - a random hex ID, identifying connections and requests
+func newId() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// socketName - the socket a websocket upgrade request is for, which is the first segment of its path, e.g. /{socket}
+func socketName(rc *fasthttp.RequestCtx) string {
+	return strings.SplitN(strings.TrimPrefix(string(rc.Path()), "/"), "/", 2)[0]
+}
+
+// socketEvents - the events that have workers registered for the named socket
+func socketEvents(pool worker.WorkerPool, socket string) map[triggers.WebsocketEvent]bool {
+	events := make(map[triggers.WebsocketEvent]bool)
+
+	for _, w := range pool.GetWorkers(&worker.GetWorkerOptions{
+		Filter: func(w worker.Worker) bool {
+			ww, ok := worker.BaseWorker(w).(*worker.WebsocketWorker)
+			return ok && ww.Socket() == socket
+		},
+	}) {
+		events[worker.BaseWorker(w).(*worker.WebsocketWorker).Event()] = true
+	}
+
+	return events
+}
+
+// handleWebsocket - upgrades the request to a websocket connection and relays its events to the socket's workers
+func (s *BaseHttpGateway) handleWebsocket(rc *fasthttp.RequestCtx, pool worker.WorkerPool) {
+	socket := socketName(rc)
+	events := socketEvents(pool, socket)
+
+	if len(events) == 0 {
+		rc.Error("Not Found", 404)
+		return
+	}
+
+	id, err := newId()
+	if err != nil {
+		rc.Error("Unable to create connection", 500)
+		return
+	}
+
+	// Lines logged for the lifetime of the connection are correlated by its ID
+	ctx := logger.WithFields(context.Background(), logger.Fields{
+		"socket":        socket,
+		"connection_id": id,
+	})
+	log := logger.FromContext(ctx)
+
+	query := make(map[string][]string)
+	rc.QueryArgs().VisitAll(func(key []byte, val []byte) {
+		k := string(key)
+		query[k] = append(query[k], string(val))
+	})
+
+	if events[triggers.WebsocketEvent_Connect] {
+		// The connect worker may reject the connection before it is upgraded
+		err := worker.DispatchWebsocketMessage(ctx, pool, &triggers.WebsocketMessage{
+			Socket:       socket,
+			Event:        triggers.WebsocketEvent_Connect,
+			ConnectionID: id,
+			Query:        query,
+		})
+		if err != nil {
+			rc.Error("Connection Refused", 403)
+			return
+		}
+	}
+
+	err = s.upgrader.Upgrade(rc, func(conn *websocket.Conn) {
+		wsConn := &websocketConnection{conn: conn}
+		s.websockets.add(socket, id, wsConn)
+
+		defer func() {
+			s.websockets.remove(socket, id)
+			_ = conn.Close()
+
+			if events[triggers.WebsocketEvent_Disconnect] {
+				err := worker.DispatchWebsocketMessage(ctx, pool, &triggers.WebsocketMessage{
+					Socket:       socket,
+					Event:        triggers.WebsocketEvent_Disconnect,
+					ConnectionID: id,
+					Query:        query,
+				})
+				if err != nil {
+					logger.WithError(log, err).Error("could not handle websocket disconnect")
+				}
+			}
+		}()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				// The client has disconnected
+				return
+			}
+
+			if !events[triggers.WebsocketEvent_Message] {
+				continue
+			}
+
+			err = worker.DispatchWebsocketMessage(ctx, pool, &triggers.WebsocketMessage{
+				Socket:       socket,
+				Event:        triggers.WebsocketEvent_Message,
+				ConnectionID: id,
+				Query:        query,
+				Body:         message,
+			})
+			if err != nil {
+				logger.WithError(log, err).Error("could not handle websocket message")
+			}
+		}
+	})
+	if err != nil {
+		logger.WithError(log, err).Error("could not upgrade websocket connection")
+	}
+}
+
+// Send - sends data to a connected websocket client, as a text message if it is valid UTF-8 otherwise as a binary message
+func (s *BaseHttpGateway) Send(ctx context.Context, socket string, connectionId string, data []byte) error {
+	conn, err := s.websockets.get(socket, connectionId)
+	if err != nil {
+		return err
+	}
+
+	messageType := websocket.BinaryMessage
+	if utf8.Valid(data) {
+		messageType = websocket.TextMessage
+	}
+
+	return conn.write(messageType, data)
+}
+
+// Close - closes the connection of a connected websocket client
+func (s *BaseHttpGateway) Close(ctx context.Context, socket string, connectionId string) error {
+	conn, err := s.websockets.get(socket, connectionId)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.write(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+
+	// Expire reads to end the connection's read loop, which closes and removes it and dispatches the disconnect event.
+	// Closing the hijacked connection directly has no effect until its handler returns.
+	return conn.conn.SetReadDeadline(time.Now())
+}
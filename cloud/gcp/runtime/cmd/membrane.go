@@ -15,12 +15,11 @@
 package main
 
 import (
-	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"syscall"
 
+	local_events "github.com/nitrictech/nitric/cloud/common/runtime/events"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/core"
 	firestore_service "github.com/nitrictech/nitric/cloud/gcp/runtime/document"
 	pubsub_service "github.com/nitrictech/nitric/cloud/gcp/runtime/events"
@@ -28,6 +27,7 @@ import (
 	pubsub_queue_service "github.com/nitrictech/nitric/cloud/gcp/runtime/queue"
 	secret_manager_secret_service "github.com/nitrictech/nitric/cloud/gcp/runtime/secret"
 	storage_service "github.com/nitrictech/nitric/cloud/gcp/runtime/storage"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/membrane"
 )
 
@@ -40,45 +40,50 @@ func main() {
 	membraneOpts := membrane.DefaultMembraneOptions()
 	provider, err := core.New()
 	if err != nil {
-		log.Default().Fatalf("Failed create core provider: %s", err.Error())
+		logger.Default().WithError(err).Fatal("failed to create core provider")
 	}
 
 	membraneOpts.SecretPlugin, err = secret_manager_secret_service.New()
 	if err != nil {
-		log.Default().Println("Failed to load secret plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load secret plugin")
 	}
 
 	membraneOpts.DocumentPlugin, err = firestore_service.New()
 	if err != nil {
-		log.Default().Println("Failed to load document plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load document plugin")
 	}
 
-	membraneOpts.EventsPlugin, err = pubsub_service.New(provider)
-	if err != nil {
-		log.Default().Println("Failed to load events plugin:", err.Error())
+	if local_events.Enabled() {
+		membraneOpts.CreateEventsPlugin = local_events.New
+	} else {
+		membraneOpts.EventsPlugin, err = pubsub_service.New(provider)
+		if err != nil {
+			logger.Default().WithError(err).Warn("failed to load events plugin")
+		}
 	}
 
 	membraneOpts.StoragePlugin, err = storage_service.New()
 	if err != nil {
-		log.Default().Println("Failed to load storage plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load storage plugin")
 	}
 
 	membraneOpts.GatewayPlugin, err = cloudrun_plugin.New()
 	if err != nil {
-		log.Default().Println("Failed to load gateway plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load gateway plugin")
 	}
 
 	membraneOpts.QueuePlugin, err = pubsub_queue_service.New()
 	if err != nil {
-		log.Default().Println("Failed to load queue plugin:", err.Error())
+		logger.Default().WithError(err).Warn("failed to load queue plugin")
 	}
 
 	membraneOpts.ResourcesPlugin = provider
 	membraneOpts.CreateTracerProvider = newTraceProvider
+	membraneOpts.CreateMeterProvider = newMeterProvider
 
 	m, err := membrane.New(membraneOpts)
 	if err != nil {
-		log.Fatalf("There was an error initialising the membrane server: %v", err)
+		logger.Default().WithError(err).Fatal("could not initialise the membrane server")
 	}
 
 	errChan := make(chan error)
@@ -89,9 +94,9 @@ func main() {
 
 	select {
 	case membraneError := <-errChan:
-		log.Default().Println(fmt.Sprintf("Membrane Error: %v, exiting", membraneError))
+		logger.Default().WithError(membraneError).Error("membrane error, exiting")
 	case sigTerm := <-term:
-		log.Default().Println(fmt.Sprintf("Received %v, exiting", sigTerm))
+		logger.Default().WithField("signal", sigTerm).Info("received signal, exiting")
 	}
 
 	m.Stop()
@@ -0,0 +1,41 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	"github.com/nitrictech/nitric/core/pkg/utils"
+)
+
+func newMeterProvider(ctx context.Context) (*sdkmetric.MeterProvider, error) {
+	exp, err := metrics.NewOTLPExporter(ctx, utils.GetEnv("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", metrics.DefaultOTLPEndpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := newResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp)),
+		sdkmetric.WithResource(res),
+	), nil
+}
@@ -19,39 +19,41 @@ import (
 	"os"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/propagator"
-	"github.com/pkg/errors"
 	"go.opentelemetry.io/contrib/detectors/gcp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
 	"github.com/nitrictech/nitric/core/pkg/span"
-	"github.com/nitrictech/nitric/core/pkg/utils"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 )
 
+// newResource - describes the membrane in exported telemetry
+func newResource(ctx context.Context) (*resource.Resource, error) {
+	return resource.New(ctx,
+		resource.WithDetectors(gcp.NewDetector()),
+		resource.WithAttributes(
+			semconv.CloudProviderGCP,
+			semconv.CloudPlatformGCPCloudRun,
+			attribute.Key("component").String("Nitric membrane"),
+		),
+		resource.WithAttributes(tracing.ResourceAttributes(os.Getenv("K_SERVICE"))...),
+	)
+}
+
 func newTraceProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
 	span.FunctionName = os.Getenv("K_SERVICE")
 	span.UseFuncNameAsSpanName = false
 
-	exp, err := otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	exp, err := tracing.NewSpanExporter(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := resource.New(ctx,
-		resource.WithDetectors(gcp.NewDetector()),
-		resource.WithAttributes(
-			semconv.CloudProviderGCP,
-			semconv.CloudPlatformGCPCloudRun,
-			attribute.Key("component").String("Nitric membrane"),
-			semconv.ServiceNameKey.String(span.FunctionName),
-			semconv.ServiceNamespaceKey.String(utils.GetEnv("NITRIC_STACK", "")),
-		),
-	)
+	res, err := newResource(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +64,13 @@ func newTraceProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
 			propagation.TraceContext{},
 		))
 
-	rate, err := utils.PercentFromIntString(utils.GetEnv("NITRIC_TRACE_SAMPLE_PERCENT", "10"))
+	sampler, err := tracing.Sampler()
 	if err != nil {
-		return nil, errors.WithMessagef(err, "NITRIC_TRACE_SAMPLE_PERCENT should be an int")
+		return nil, err
 	}
 
 	return sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(rate))),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithResource(res),
 		sdktrace.WithBatcher(exp),
 	), nil
@@ -16,9 +16,10 @@
 package gateway
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/propagator"
 	"github.com/valyala/fasthttp"
@@ -33,14 +34,48 @@ import (
 
 type PubSubMessage struct {
 	Message struct {
-		Attributes map[string]string `json:"attributes"`
-		Data       []byte            `json:"data,omitempty"`
-		ID         string            `json:"id"`
+		Attributes  map[string]string `json:"attributes"`
+		Data        []byte            `json:"data,omitempty"`
+		ID          string            `json:"id"`
+		MessageID   string            `json:"messageId"`
+		PublishTime time.Time         `json:"publishTime"`
 	} `json:"message"`
 	Subscription string `json:"subscription"`
+	// Only reported for subscriptions with a dead letter topic
+	DeliveryAttempt int `json:"deliveryAttempt"`
+}
+
+// handleCloudScheduler - handles a Cloud Scheduler job firing a schedule.
+// The job is identified by the nitric schedule header if set, otherwise by its name.
+func handleCloudScheduler(rc *fasthttp.RequestCtx, pool worker.WorkerPool) {
+	key := string(rc.Request.Header.Peek(base_http.ScheduleKeyHeader))
+	if key == "" {
+		jobName := string(rc.Request.Header.Peek("X-CloudScheduler-JobName"))
+		key = jobName[strings.LastIndex(jobName, "/")+1:]
+	}
+
+	sched, err := base_http.ScheduleFromRequest(rc, key)
+	if err != nil {
+		rc.Error(err.Error(), 400)
+		return
+	}
+
+	if scheduleTime := rc.Request.Header.Peek("X-CloudScheduler-ScheduleTime"); len(scheduleTime) > 0 {
+		if t, err := time.Parse(time.RFC3339, string(scheduleTime)); err == nil {
+			sched.ScheduledTime = t
+		}
+	}
+
+	base_http.HandleSchedule(rc, pool, sched)
 }
 
 func middleware(rc *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
+	// Cloud Scheduler http targets invoke the service directly
+	if string(rc.Request.Header.Peek("X-CloudScheduler")) == "true" {
+		handleCloudScheduler(rc, pool)
+		return false
+	}
+
 	bodyBytes := rc.Request.Body()
 
 	// Check if the payload contains a pubsub event
@@ -52,6 +87,11 @@ func middleware(rc *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 
 		topic := pubsubEvent.Message.Attributes["x-nitric-topic"]
 
+		messageId := pubsubEvent.Message.MessageID
+		if messageId == "" {
+			messageId = pubsubEvent.Message.ID
+		}
+
 		// need to determine if the underlying data is a nitric event
 		var event *triggers.Event
 		messageJson := &ep.NitricEvent{}
@@ -61,14 +101,15 @@ func middleware(rc *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 			payload, _ := json.Marshal(messageJson.Payload)
 
 			event = &triggers.Event{
-				ID:         messageJson.ID,
-				Topic:      topic,
-				Payload:    payload,
-				Attributes: pubsubEvent.Message.Attributes,
+				ID:          messageJson.ID,
+				Topic:       topic,
+				PayloadType: messageJson.PayloadType,
+				Payload:     payload,
+				Attributes:  pubsubEvent.Message.Attributes,
 			}
 		} else {
 			event = &triggers.Event{
-				ID: pubsubEvent.Message.ID,
+				ID: messageId,
 				// Set the topic
 				Topic: topic,
 				// Set the original full payload payload
@@ -77,16 +118,11 @@ func middleware(rc *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 			}
 		}
 
-		wrkr, err := pool.GetWorker(&worker.GetWorkerOptions{
-			Event: event,
-		})
-		if err != nil {
-			rc.Error("Could not find handle for event", 500)
-			return false
-		}
+		event.PublishTime = pubsubEvent.Message.PublishTime
+		event.DeliveryAttempt = pubsubEvent.DeliveryAttempt
 
 		traceKey := propagator.CloudTraceFormatPropagator{}.Fields()[0]
-		ctx := context.TODO()
+		ctx := base_http.RequestContext(rc)
 
 		if pubsubEvent.Message.Attributes[traceKey] != "" {
 			var mc propagation.MapCarrier = pubsubEvent.Message.Attributes
@@ -96,7 +132,7 @@ func middleware(rc *fasthttp.RequestCtx, pool worker.WorkerPool) bool {
 			ctx = propagator.CloudTraceFormatPropagator{}.Extract(ctx, hc)
 		}
 
-		if err := wrkr.HandleEvent(ctx, event); err == nil {
+		if err := worker.DispatchEvent(ctx, pool, event); err == nil {
 			// return a successful response
 			rc.SuccessString("text/plain", "success")
 		} else {
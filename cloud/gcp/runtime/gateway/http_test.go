@@ -16,6 +16,7 @@ package gateway_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -37,8 +38,27 @@ import (
 
 const GATEWAY_ADDRESS = "127.0.0.1:9001"
 
+// scheduleAdapter - records the schedules fired to it
+type scheduleAdapter struct {
+	worker.UnimplementedWorker
+	received []*triggers.Schedule
+}
+
+func (a *scheduleAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return a.UnimplementedWorker.HandleEvent(trigger)
+}
+
+func (a *scheduleAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return a.UnimplementedWorker.HandleHttpRequest(trigger)
+}
+
+func (a *scheduleAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	a.received = append(a.received, trigger)
+	return nil
+}
+
 var _ = Describe("Http", func() {
-	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 2})
 	gatewayUrl := fmt.Sprintf("http://%s", GATEWAY_ADDRESS)
 	// Set this to loopback to ensure its not public in our CI/Testing environments
 	BeforeSuite(func() {
@@ -54,6 +74,13 @@ var _ = Describe("Http", func() {
 	err := pool.AddWorker(mockHandler)
 	Expect(err).To(BeNil())
 
+	schedules := &scheduleAdapter{}
+	err = pool.AddWorker(worker.NewScheduleWorker(schedules, &worker.ScheduleWorkerOptions{
+		Key:  "Nightly Report",
+		Cron: "0 2 * * *",
+	}))
+	Expect(err).To(BeNil())
+
 	httpPlugin, err := cloudrun_plugin.New()
 	Expect(err).To(BeNil())
 
@@ -68,6 +95,7 @@ var _ = Describe("Http", func() {
 
 	AfterEach(func() {
 		mockHandler.Reset()
+		schedules.received = nil
 	})
 
 	When("Invoking the GCP HTTP Gateway", func() {
@@ -141,9 +169,11 @@ var _ = Describe("Http", func() {
 					"attributes": map[string]string{
 						"x-nitric-topic": "test",
 					},
-					"id":   "test",
-					"data": b64Event,
+					"id":          "test",
+					"data":        b64Event,
+					"publishTime": "2022-01-03T10:00:00Z",
 				},
+				"deliveryAttempt": 2,
 			})
 
 			It("Should handle the event successfully", func() {
@@ -171,6 +201,12 @@ var _ = Describe("Http", func() {
 				By("Passing through the published message data")
 				Expect(handledEvent.Payload).To(BeEquivalentTo(pBytes))
 
+				By("Passing through the event metadata")
+				Expect(handledEvent.PayloadType).To(Equal("Test Payload"))
+				Expect(handledEvent.Attributes).To(HaveKeyWithValue("x-nitric-topic", "test"))
+				Expect(handledEvent.PublishTime).To(Equal(time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC)))
+				Expect(handledEvent.DeliveryAttempt).To(Equal(2))
+
 				By("The request returns a successful status")
 				Expect(resp.StatusCode).To(Equal(200))
 
@@ -179,4 +215,35 @@ var _ = Describe("Http", func() {
 			})
 		})
 	})
+
+	When("Invoked by Cloud Scheduler", func() {
+		It("Should handle the schedule successfully", func() {
+			request, err := http.NewRequest("POST", gatewayUrl, nil)
+			Expect(err).To(BeNil())
+			request.Header.Add("X-CloudScheduler", "true")
+			request.Header.Add("X-CloudScheduler-JobName", "nightly-report")
+			request.Header.Add("X-CloudScheduler-ScheduleTime", "2022-01-04T02:00:00Z")
+			request.Header.Add("X-Nitric-Schedule", "Nightly Report")
+			resp, err := http.DefaultClient.Do(request)
+			Expect(err).To(BeNil())
+
+			By("The request returns a successful status")
+			Expect(resp.StatusCode).To(Equal(200))
+
+			By("Handling the request as a schedule, not an http request")
+			Expect(mockHandler.ReceivedRequests).To(BeEmpty())
+			Expect(schedules.received).To(HaveLen(1))
+
+			sched := schedules.received[0]
+
+			By("Retaining the original schedule key")
+			Expect(sched.Key).To(Equal("Nightly Report"))
+
+			By("Using the scheduled time of the job")
+			Expect(sched.ScheduledTime).To(Equal(time.Date(2022, time.January, 4, 2, 0, 0, 0, time.UTC)))
+
+			By("Including the cadence of the schedule")
+			Expect(sched.Cadence).To(Equal("0 2 * * *"))
+		})
+	})
 })
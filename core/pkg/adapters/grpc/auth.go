@@ -0,0 +1,75 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TokenMetadataKey - the gRPC metadata key clients must use to present the service token
+const TokenMetadataKey = "authorization"
+
+const bearerPrefix = "Bearer "
+
+// checkToken - verifies the incoming context carries the expected bearer token
+func checkToken(ctx context.Context, token string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing service token")
+	}
+
+	for _, v := range md.Get(TokenMetadataKey) {
+		if !strings.HasPrefix(v, bearerPrefix) {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(v, bearerPrefix)), []byte(token)) == 1 {
+			return nil
+		}
+
+		return status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	return status.Error(codes.Unauthenticated, "missing service token")
+}
+
+// TokenUnaryInterceptor - rejects unary calls that do not present the given service token
+func TokenUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkToken(ctx, token); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// TokenStreamInterceptor - rejects streams that do not present the given service token
+func TokenStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkToken(ss.Context(), token); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
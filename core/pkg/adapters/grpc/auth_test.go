@@ -0,0 +1,64 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/adapters/grpc"
+)
+
+var _ = Describe("Token Authentication", func() {
+	interceptor := grpc.TokenUnaryInterceptor("secret-token")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+
+	When("no token is provided", func() {
+		resp, err := interceptor(context.Background(), nil, &grpclib.UnaryServerInfo{}, handler)
+
+		It("Should return Unauthenticated", func() {
+			Expect(resp).To(BeNil())
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	When("an invalid token is provided", func() {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc.TokenMetadataKey, "Bearer wrong-token"))
+		resp, err := interceptor(ctx, nil, &grpclib.UnaryServerInfo{}, handler)
+
+		It("Should return Unauthenticated", func() {
+			Expect(resp).To(BeNil())
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	When("the correct token is provided", func() {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpc.TokenMetadataKey, "Bearer secret-token"))
+		resp, err := interceptor(ctx, nil, &grpclib.UnaryServerInfo{}, handler)
+
+		It("Should call the handler", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp).To(Equal("handled"))
+		})
+	})
+})
@@ -32,6 +32,7 @@ type DocumentServiceServer struct {
 	// TODO: Support multiple plugin registrations
 	// Just need to settle on a way of addressing them on calls
 	documentPlugin document.DocumentService
+	policy         PolicyEnforcer
 }
 
 func (s *DocumentServiceServer) checkPluginRegistered() error {
@@ -51,6 +52,10 @@ func (s *DocumentServiceServer) Get(ctx context.Context, req *pb.DocumentGetRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Get", err)
 	}
 
+	if err := checkPermission(s.policy, "DocumentService.Get", pb.Action_CollectionDocumentRead, pb.ResourceType_Collection, rootCollectionName(req.GetKey().GetCollection())); err != nil {
+		return nil, err
+	}
+
 	key := keyFromWire(req.Key)
 
 	doc, err := s.documentPlugin.Get(ctx, key)
@@ -77,6 +82,10 @@ func (s *DocumentServiceServer) Set(ctx context.Context, req *pb.DocumentSetRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Set", err)
 	}
 
+	if err := checkPermission(s.policy, "DocumentService.Set", pb.Action_CollectionDocumentWrite, pb.ResourceType_Collection, rootCollectionName(req.GetKey().GetCollection())); err != nil {
+		return nil, err
+	}
+
 	key := keyFromWire(req.Key)
 
 	err := s.documentPlugin.Set(ctx, key, req.GetContent().AsMap())
@@ -96,6 +105,10 @@ func (s *DocumentServiceServer) Delete(ctx context.Context, req *pb.DocumentDele
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Delete", err)
 	}
 
+	if err := checkPermission(s.policy, "DocumentService.Delete", pb.Action_CollectionDocumentDelete, pb.ResourceType_Collection, rootCollectionName(req.GetKey().GetCollection())); err != nil {
+		return nil, err
+	}
+
 	key := keyFromWire(req.Key)
 
 	err := s.documentPlugin.Delete(ctx, key)
@@ -115,6 +128,10 @@ func (s *DocumentServiceServer) Query(ctx context.Context, req *pb.DocumentQuery
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "DocumentService.Query", err)
 	}
 
+	if err := checkPermission(s.policy, "DocumentService.Query", pb.Action_CollectionQuery, pb.ResourceType_Collection, rootCollectionName(req.GetCollection())); err != nil {
+		return nil, err
+	}
+
 	collection := collectionFromWire(req.Collection)
 	expressions := expressionsFromWire(req.GetExpressions())
 
@@ -147,6 +164,10 @@ func (s *DocumentServiceServer) QueryStream(req *pb.DocumentQueryStreamRequest,
 		return err
 	}
 
+	if err := checkPermission(s.policy, "DocumentService.QueryStream", pb.Action_CollectionQuery, pb.ResourceType_Collection, rootCollectionName(req.GetCollection())); err != nil {
+		return err
+	}
+
 	col := collectionFromWire(req.Collection)
 	expressions := expressionsFromWire(req.Expressions)
 
@@ -172,12 +193,24 @@ func (s *DocumentServiceServer) QueryStream(req *pb.DocumentQueryStreamRequest,
 	return nil
 }
 
-func NewDocumentServer(docPlugin document.DocumentService) pb.DocumentServiceServer {
+func NewDocumentServer(docPlugin document.DocumentService, opts ...ServiceServerOption) pb.DocumentServiceServer {
+	o := newServiceServerOptions(opts)
+
 	return &DocumentServiceServer{
 		documentPlugin: docPlugin,
+		policy:         o.policy,
 	}
 }
 
+// rootCollectionName - returns the name of the top level collection, which is the declared resource for sub-collections
+func rootCollectionName(col *pb.Collection) string {
+	for col.GetParent().GetCollection() != nil {
+		col = col.GetParent().GetCollection()
+	}
+
+	return col.GetName()
+}
+
 func documentToWire(doc *document.Document) (*pb.Document, error) {
 	valStruct, err := protoutils.NewStruct(doc.Content)
 	if err != nil {
@@ -16,12 +16,12 @@ package grpc
 
 import (
 	"fmt"
-	"reflect"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
 )
 
@@ -82,52 +82,7 @@ func NewPluginNotRegisteredError(plugin string) error {
 	return s.Err()
 }
 
+// LogArg - formats a plugin error argument for error details, only struct fields tagged with log are included
 func LogArg(arg interface{}) string {
-	value := getValue(arg)
-
-	if value.Kind() == reflect.Struct {
-		str := "{"
-		for i := 0; i < value.NumField(); i++ {
-			fieldType := value.Type().Field(i)
-			tag := fieldType.Tag.Get("log")
-			if tag == "" || tag == "-" {
-				continue
-			}
-
-			if len(str) > 1 {
-				str += ", "
-			}
-
-			field := value.Field(i)
-			str += fieldType.Name + ": " + LogArg(field.Interface())
-		}
-		str += "}"
-
-		return str
-	} else if value.Kind() == reflect.Map {
-		str := "{"
-
-		for k, v := range arg.(map[string]interface{}) {
-			if len(str) > 1 {
-				str += ", "
-			}
-			str += fmt.Sprintf("%v", k) + ": " + LogArg(v)
-		}
-
-		str += "}"
-
-		return str
-	} else {
-		return fmt.Sprintf("%v", arg)
-	}
-}
-
-func getValue(x interface{}) reflect.Value {
-	val := reflect.ValueOf(x)
-
-	if val.Kind() == reflect.Ptr {
-		val = val.Elem()
-	}
-
-	return val
+	return logger.FormatArg(arg)
 }
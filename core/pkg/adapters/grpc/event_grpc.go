@@ -28,6 +28,7 @@ import (
 type EventServiceServer struct {
 	pb.UnimplementedEventServiceServer
 	eventPlugin events.EventService
+	policy      PolicyEnforcer
 }
 
 func (s *EventServiceServer) checkPluginRegistered() error {
@@ -47,6 +48,10 @@ func (s *EventServiceServer) Publish(ctx context.Context, req *pb.EventPublishRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "EventService.Publish", err)
 	}
 
+	if err := checkPermission(s.policy, "EventService.Publish", pb.Action_TopicEventPublish, pb.ResourceType_Topic, req.GetTopic()); err != nil {
+		return nil, err
+	}
+
 	// auto generate an ID if we did not receive one
 	ID := req.GetEvent().GetId()
 	if ID == "" {
@@ -68,15 +73,19 @@ func (s *EventServiceServer) Publish(ctx context.Context, req *pb.EventPublishRe
 	}
 }
 
-func NewEventServiceServer(eventsPlugin events.EventService) pb.EventServiceServer {
+func NewEventServiceServer(eventsPlugin events.EventService, opts ...ServiceServerOption) pb.EventServiceServer {
+	o := newServiceServerOptions(opts)
+
 	return &EventServiceServer{
 		eventPlugin: eventsPlugin,
+		policy:      o.policy,
 	}
 }
 
 type TopicServiceServer struct {
 	pb.UnimplementedTopicServiceServer
 	eventPlugin events.EventService
+	policy      PolicyEnforcer
 }
 
 func (s *TopicServiceServer) checkPluginRegistered() error {
@@ -96,6 +105,10 @@ func (s *TopicServiceServer) List(ctx context.Context, req *pb.TopicListRequest)
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "TopicService.List", err)
 	}
 
+	if err := checkPermission(s.policy, "TopicService.List", pb.Action_TopicList, pb.ResourceType_Topic, ""); err != nil {
+		return nil, err
+	}
+
 	if res, err := s.eventPlugin.ListTopics(ctx); err == nil {
 		topics := make([]*pb.NitricTopic, len(res))
 		for i, topicName := range res {
@@ -112,10 +125,13 @@ func (s *TopicServiceServer) List(ctx context.Context, req *pb.TopicListRequest)
 	}
 }
 
-func NewTopicServiceServer(eventService events.EventService) pb.TopicServiceServer {
+func NewTopicServiceServer(eventService events.EventService, opts ...ServiceServerOption) pb.TopicServiceServer {
+	o := newServiceServerOptions(opts)
+
 	// The external topic/event interfaces are separate. Internally, they're fulfilled together,
 	// so the event plugin is all that's needed for both the Event and Topic servers currently.
 	return &TopicServiceServer{
 		eventPlugin: eventService,
+		policy:      o.policy,
 	}
 }
@@ -15,19 +15,93 @@
 package grpc
 
 import (
-	"log"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
 	"github.com/nitrictech/nitric/core/pkg/worker"
 )
 
 type FaasServer struct {
 	pb.UnimplementedFaasServiceServer
 	pool worker.WorkerPool
+	// Validates requests to secured api routes, route security is not enforced if nil
+	authorizer worker.Authorizer
+	// The time a trigger waits for a worker at its maximum concurrency to finish another
+	concurrencyWait time.Duration
+	// Heartbeats are sent to workers that support them at this interval, disabled if 0
+	heartbeatInterval time.Duration
+	heartbeatTimeout  time.Duration
+	// Called after a worker that stopped responding to heartbeats has been removed from the pool
+	onUnresponsive func(worker.Worker)
+}
+
+type FaasServerOption = func(*FaasServer)
+
+// WithRouteAuthorizer - Enforces the security options of api workers using the given authorizer
+func WithRouteAuthorizer(authorizer worker.Authorizer) FaasServerOption {
+	return func(srv *FaasServer) {
+		srv.authorizer = authorizer
+	}
+}
+
+// WithConcurrencyWait - Sets the time a trigger waits for a worker at its maximum concurrency to finish another,
+// before the worker reports it is overloaded
+func WithConcurrencyWait(wait time.Duration) FaasServerOption {
+	return func(srv *FaasServer) {
+		srv.concurrencyWait = wait
+	}
+}
+
+// WithHeartbeat - Pings workers that support heartbeats at the interval,
+// removing them from the pool if nothing is received from them within the timeout
+func WithHeartbeat(interval time.Duration, timeout time.Duration) FaasServerOption {
+	return func(srv *FaasServer) {
+		srv.heartbeatInterval = interval
+		srv.heartbeatTimeout = timeout
+	}
+}
+
+// WithUnresponsiveHandler - Calls the handler after a worker that stopped responding to heartbeats has been removed from the pool
+func WithUnresponsiveHandler(handler func(worker.Worker)) FaasServerOption {
+	return func(srv *FaasServer) {
+		srv.onUnresponsive = handler
+	}
+}
+
+// routeSecurity - converts api worker security options to route worker security requirements
+func routeSecurity(opts *pb.ApiWorkerOptions) map[string][]string {
+	if len(opts.GetSecurity()) == 0 {
+		return nil
+	}
+
+	security := make(map[string][]string)
+	for name, scopes := range opts.GetSecurity() {
+		security[name] = scopes.GetScopes()
+	}
+
+	return security
+}
+
+// initWorkerType - the type of worker registered by the init request, named as by worker.TypeOf
+func initWorkerType(ir *pb.InitRequest) string {
+	switch {
+	case ir.GetApi() != nil:
+		return "route"
+	case ir.GetSubscription() != nil:
+		return "subscription"
+	case ir.GetSchedule() != nil:
+		return "schedule"
+	case ir.GetWebsocket() != nil:
+		return "websocket"
+	default:
+		return "faas"
+	}
 }
 
 // Starts a new stream
@@ -47,8 +121,24 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 		return status.Error(codes.FailedPrecondition, "first message must be InitRequest")
 	}
 
+	log := logger.Default().WithField(logger.WorkerTypeKey, initWorkerType(ir))
+
+	adapterOpts := &worker.GrpcAdapterOptions{
+		BodyStreaming:    ir.GetBodyStreaming(),
+		ScheduleTriggers: ir.GetScheduleTriggers(),
+		MaxConcurrency:   int(ir.GetMaxConcurrency()),
+		ConcurrencyWait:  s.concurrencyWait,
+		Logger:           log,
+	}
+
+	// Workers that don't respond to pings would be evicted, so heartbeats are only sent to those that declare support
+	if ir.GetHeartbeat() {
+		adapterOpts.HeartbeatInterval = s.heartbeatInterval
+		adapterOpts.HeartbeatTimeout = s.heartbeatTimeout
+	}
+
 	var wrkr worker.Worker
-	adapter := worker.NewGrpcAdapter(stream)
+	adapter := worker.NewGrpcAdapter(stream, adapterOpts)
 
 	if api := ir.GetApi(); api != nil {
 		// Create a new route worker
@@ -56,6 +146,10 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 			Api:     api.Api,
 			Path:    api.Path,
 			Methods: api.Methods,
+
+			Security:         routeSecurity(api.GetOptions()),
+			SecurityDisabled: api.GetOptions().GetSecurityDisabled(),
+			Authorizer:       s.authorizer,
 		})
 	} else if subscription := ir.GetSubscription(); subscription != nil {
 		wrkr = worker.NewSubscriptionWorker(adapter, &worker.SubscriptionWorkerOptions{
@@ -63,7 +157,14 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 		})
 	} else if schedule := ir.GetSchedule(); schedule != nil {
 		wrkr = worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{
-			Key: schedule.Key,
+			Key:  schedule.Key,
+			Rate: schedule.GetRate().GetRate(),
+			Cron: schedule.GetCron().GetCron(),
+		})
+	} else if websocket := ir.GetWebsocket(); websocket != nil {
+		wrkr = worker.NewWebsocketWorker(adapter, &worker.WebsocketWorkerOptions{
+			Socket: websocket.Socket,
+			Event:  triggers.WebsocketEvent(websocket.Event),
 		})
 	} else {
 		// XXX: Catch all worker type
@@ -86,10 +187,15 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 
 	// block here on error returned from the worker
 	err = <-errchan
-	log.Default().Println("FaaS stream closed, removing worker")
+	log.Info("FaaS stream closed, removing worker")
 
 	// Worker is done so we can remove it from the pool
 	rwErr := s.pool.RemoveWorker(wrkr)
+
+	if errors.Is(err, worker.ErrUnresponsive) && s.onUnresponsive != nil {
+		s.onUnresponsive(wrkr)
+	}
+
 	if rwErr != nil {
 		if err != nil {
 			return errors.Wrap(err, rwErr.Error())
@@ -100,8 +206,14 @@ func (s *FaasServer) TriggerStream(stream pb.FaasService_TriggerStreamServer) er
 	return err
 }
 
-func NewFaasServer(workerPool worker.WorkerPool) *FaasServer {
-	return &FaasServer{
+func NewFaasServer(workerPool worker.WorkerPool, opts ...FaasServerOption) *FaasServer {
+	srv := &FaasServer{
 		pool: workerPool,
 	}
+
+	for _, o := range opts {
+		o(srv)
+	}
+
+	return srv
 }
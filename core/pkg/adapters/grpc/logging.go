@@ -0,0 +1,94 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// clientErrorCodes - codes caused by the caller rather than the plugin, logged as warnings
+var clientErrorCodes = map[codes.Code]bool{
+	codes.Canceled:           true,
+	codes.InvalidArgument:    true,
+	codes.NotFound:           true,
+	codes.AlreadyExists:      true,
+	codes.PermissionDenied:   true,
+	codes.FailedPrecondition: true,
+	codes.OutOfRange:         true,
+	codes.Unauthenticated:    true,
+}
+
+// LoggingUnaryInterceptor - logs plugin calls, including the scope of the plugin errors they return
+func LoggingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		log := logger.FromContext(ctx).WithFields(logger.Fields{
+			"method":   info.FullMethod,
+			"duration": time.Since(start).String(),
+		})
+
+		if err == nil {
+			log.Debug("plugin call succeeded")
+			return resp, err
+		}
+
+		s := status.Convert(err)
+		log = log.WithField(logger.CodeKey, s.Code().String())
+
+		for _, d := range s.Details() {
+			if ed, ok := d.(*v1.ErrorDetails); ok {
+				log = log.WithFields(errorScopeFields(ed.GetScope()))
+			}
+		}
+
+		level := logrus.ErrorLevel
+		if clientErrorCodes[s.Code()] {
+			level = logrus.WarnLevel
+		}
+
+		log.Log(level, s.Message())
+
+		return resp, err
+	}
+}
+
+func errorScopeFields(scope *v1.ErrorScope) logger.Fields {
+	fields := logger.Fields{}
+
+	if scope.GetService() != "" {
+		fields[logger.ServiceKey] = scope.GetService()
+	}
+
+	if scope.GetPlugin() != "" {
+		fields[logger.PluginKey] = scope.GetPlugin()
+	}
+
+	if len(scope.GetArgs()) > 0 {
+		fields[logger.PluginArgsKey] = scope.GetArgs()
+	}
+
+	return fields
+}
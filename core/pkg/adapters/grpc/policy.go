@@ -0,0 +1,123 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+)
+
+// PolicyEnforcer - determines whether an action may be performed against a resource
+type PolicyEnforcer interface {
+	// Allowed - returns true if the action is permitted on the named resource.
+	// An empty name checks if the action is permitted on any resource of the given type.
+	Allowed(action v1.Action, resourceType v1.ResourceType, name string) bool
+}
+
+// PermissionTable - A PolicyEnforcer built from the policies declared via the ResourceService
+//
+// The membrane serves a single function, so any declared policy is assumed to apply
+// to that function regardless of its principals.
+type PermissionTable struct {
+	lock        sync.RWMutex
+	permissions map[v1.ResourceType]map[string]map[v1.Action]bool
+}
+
+var _ PolicyEnforcer = &PermissionTable{}
+
+// Declare - Adds the actions of the given policy to the table for each of its resources
+func (t *PermissionTable) Declare(policy *v1.PolicyResource) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, res := range policy.GetResources() {
+		if t.permissions[res.GetType()] == nil {
+			t.permissions[res.GetType()] = make(map[string]map[v1.Action]bool)
+		}
+
+		if t.permissions[res.GetType()][res.GetName()] == nil {
+			t.permissions[res.GetType()][res.GetName()] = make(map[v1.Action]bool)
+		}
+
+		for _, action := range policy.GetActions() {
+			t.permissions[res.GetType()][res.GetName()][action] = true
+		}
+	}
+}
+
+func (t *PermissionTable) Allowed(action v1.Action, resourceType v1.ResourceType, name string) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if name != "" {
+		return t.permissions[resourceType][name][action]
+	}
+
+	for _, actions := range t.permissions[resourceType] {
+		if actions[action] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewPermissionTable - Creates a new empty permission table, denying all actions until policies are declared
+func NewPermissionTable() *PermissionTable {
+	return &PermissionTable{
+		permissions: make(map[v1.ResourceType]map[string]map[v1.Action]bool),
+	}
+}
+
+// ServiceServerOption - Configures optional behaviour of the plugin service servers
+type ServiceServerOption = func(*serviceServerOptions)
+
+type serviceServerOptions struct {
+	policy PolicyEnforcer
+}
+
+// WithPolicyEnforcer - Requires calls to be permitted by the given enforcer before they reach the plugin
+func WithPolicyEnforcer(policy PolicyEnforcer) ServiceServerOption {
+	return func(o *serviceServerOptions) {
+		o.policy = policy
+	}
+}
+
+func newServiceServerOptions(opts []ServiceServerOption) *serviceServerOptions {
+	o := &serviceServerOptions{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// checkPermission - Returns a PermissionDenied error if the enforcer is set and does not allow the action
+func checkPermission(policy PolicyEnforcer, operation string, action v1.Action, resourceType v1.ResourceType, name string) error {
+	if policy == nil || policy.Allowed(action, resourceType, name) {
+		return nil
+	}
+
+	if name == "" {
+		return newGrpcErrorWithCode(codes.PermissionDenied, operation, fmt.Errorf("no policy declared allowing %s on any %s", action, resourceType))
+	}
+
+	return newGrpcErrorWithCode(codes.PermissionDenied, operation, fmt.Errorf("no policy declared allowing %s on %s %s", action, resourceType, name))
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mock_storage "github.com/nitrictech/nitric/core/mocks/storage"
+	"github.com/nitrictech/nitric/core/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+)
+
+var _ = Describe("Policy Enforcement", func() {
+	Context("PermissionTable", func() {
+		When("no policies have been declared", func() {
+			table := grpc.NewPermissionTable()
+
+			It("Should deny all actions", func() {
+				Expect(table.Allowed(v1.Action_BucketFileGet, v1.ResourceType_Bucket, "images")).To(BeFalse())
+				Expect(table.Allowed(v1.Action_TopicList, v1.ResourceType_Topic, "")).To(BeFalse())
+			})
+		})
+
+		When("a policy has been declared", func() {
+			table := grpc.NewPermissionTable()
+			table.Declare(&v1.PolicyResource{
+				Actions:   []v1.Action{v1.Action_BucketFileGet},
+				Resources: []*v1.Resource{{Type: v1.ResourceType_Bucket, Name: "images"}},
+			})
+
+			It("Should allow the declared action on the declared resource", func() {
+				Expect(table.Allowed(v1.Action_BucketFileGet, v1.ResourceType_Bucket, "images")).To(BeTrue())
+			})
+
+			It("Should allow the declared action on any resource of the type", func() {
+				Expect(table.Allowed(v1.Action_BucketFileGet, v1.ResourceType_Bucket, "")).To(BeTrue())
+			})
+
+			It("Should deny undeclared actions", func() {
+				Expect(table.Allowed(v1.Action_BucketFileDelete, v1.ResourceType_Bucket, "images")).To(BeFalse())
+			})
+
+			It("Should deny the action on other resources", func() {
+				Expect(table.Allowed(v1.Action_BucketFileGet, v1.ResourceType_Bucket, "files")).To(BeFalse())
+			})
+		})
+	})
+
+	Context("Declaring through the ResourceService", func() {
+		table := grpc.NewPermissionTable()
+		rs := grpc.NewResourcesServiceServer(grpc.WithPermissionTable(table))
+
+		_, err := rs.Declare(context.Background(), &v1.ResourceDeclareRequest{
+			Resource: &v1.Resource{Type: v1.ResourceType_Policy, Name: "policy"},
+			Config: &v1.ResourceDeclareRequest_Policy{
+				Policy: &v1.PolicyResource{
+					Actions:   []v1.Action{v1.Action_QueueSend},
+					Resources: []*v1.Resource{{Type: v1.ResourceType_Queue, Name: "jobs"}},
+				},
+			},
+		})
+
+		It("Should add the policy to the table", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(table.Allowed(v1.Action_QueueSend, v1.ResourceType_Queue, "jobs")).To(BeTrue())
+		})
+	})
+
+	Context("Enforcing on the StorageService", func() {
+		When("the action has not been declared", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			table := grpc.NewPermissionTable()
+			table.Declare(&v1.PolicyResource{
+				Actions:   []v1.Action{v1.Action_BucketFileGet},
+				Resources: []*v1.Resource{{Type: v1.ResourceType_Bucket, Name: "images"}},
+			})
+
+			resp, err := grpc.NewStorageServiceServer(mockSS, grpc.WithPolicyEnforcer(table)).Delete(context.Background(), &v1.StorageDeleteRequest{
+				BucketName: "images",
+				Key:        "cat.png",
+			})
+
+			It("Should return PermissionDenied without calling the plugin", func() {
+				Expect(resp).Should(BeNil())
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(err.Error()).Should(ContainSubstring("no policy declared allowing BucketFileDelete on Bucket images"))
+			})
+		})
+
+		When("the action has been declared", func() {
+			g := gomock.NewController(GinkgoT())
+			mockSS := mock_storage.NewMockStorageService(g)
+			table := grpc.NewPermissionTable()
+			table.Declare(&v1.PolicyResource{
+				Actions:   []v1.Action{v1.Action_BucketFileDelete},
+				Resources: []*v1.Resource{{Type: v1.ResourceType_Bucket, Name: "images"}},
+			})
+
+			mockSS.EXPECT().Delete(gomock.Any(), "images", "cat.png").Return(nil)
+
+			resp, err := grpc.NewStorageServiceServer(mockSS, grpc.WithPolicyEnforcer(table)).Delete(context.Background(), &v1.StorageDeleteRequest{
+				BucketName: "images",
+				Key:        "cat.png",
+			})
+
+			It("Should call the plugin", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp).ShouldNot(BeNil())
+			})
+		})
+	})
+})
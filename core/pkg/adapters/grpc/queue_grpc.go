@@ -29,6 +29,7 @@ import (
 type QueueServiceServer struct {
 	pb.UnimplementedQueueServiceServer
 	plugin queue.QueueService
+	policy PolicyEnforcer
 }
 
 func (s *QueueServiceServer) checkPluginRegistered() error {
@@ -48,6 +49,10 @@ func (s *QueueServiceServer) Send(ctx context.Context, req *pb.QueueSendRequest)
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Send", err)
 	}
 
+	if err := checkPermission(s.policy, "QueueService.Send", pb.Action_QueueSend, pb.ResourceType_Queue, req.GetQueue()); err != nil {
+		return nil, err
+	}
+
 	task := req.GetTask()
 
 	// auto generate an ID if we did not receive one
@@ -79,6 +84,10 @@ func (s *QueueServiceServer) SendBatch(ctx context.Context, req *pb.QueueSendBat
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.SendBatch", err)
 	}
 
+	if err := checkPermission(s.policy, "QueueService.SendBatch", pb.Action_QueueSend, pb.ResourceType_Queue, req.GetQueue()); err != nil {
+		return nil, err
+	}
+
 	// Translate tasks
 	tasks := make([]queue.NitricTask, len(req.GetTasks()))
 	for i, task := range req.GetTasks() {
@@ -125,6 +134,10 @@ func (s *QueueServiceServer) Receive(ctx context.Context, req *pb.QueueReceiveRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Receive", err)
 	}
 
+	if err := checkPermission(s.policy, "QueueService.Receive", pb.Action_QueueReceive, pb.ResourceType_Queue, req.GetQueue()); err != nil {
+		return nil, err
+	}
+
 	// Convert gRPC request to plugin params
 	depth := uint32(req.GetDepth())
 	popOptions := queue.ReceiveOptions{
@@ -165,6 +178,12 @@ func (s *QueueServiceServer) Complete(ctx context.Context, req *pb.QueueComplete
 	if err := req.ValidateAll(); err != nil {
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "QueueService.Complete", err)
 	}
+
+	// Completing a task is part of receiving it
+	if err := checkPermission(s.policy, "QueueService.Complete", pb.Action_QueueReceive, pb.ResourceType_Queue, req.GetQueue()); err != nil {
+		return nil, err
+	}
+
 	// Convert gRPC request to plugin params
 	queueName := req.GetQueue()
 	leaseId := req.GetLeaseId()
@@ -179,8 +198,11 @@ func (s *QueueServiceServer) Complete(ctx context.Context, req *pb.QueueComplete
 	return &pb.QueueCompleteResponse{}, nil
 }
 
-func NewQueueServiceServer(plugin queue.QueueService) pb.QueueServiceServer {
+func NewQueueServiceServer(plugin queue.QueueService, opts ...ServiceServerOption) pb.QueueServiceServer {
+	o := newServiceServerOptions(opts)
+
 	return &QueueServiceServer{
 		plugin: plugin,
+		policy: o.policy,
 	}
 }
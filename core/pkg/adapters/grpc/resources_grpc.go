@@ -20,11 +20,14 @@ import (
 
 	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
 	"github.com/nitrictech/nitric/core/pkg/providers/common"
+	"github.com/nitrictech/nitric/core/pkg/security"
 )
 
 type ResourcesServiceServer struct {
 	v1.UnimplementedResourceServiceServer
-	plugin common.ResourceService
+	plugin      common.ResourceService
+	permissions *PermissionTable
+	apis        *security.ApiDefinitions
 }
 
 type ResourceServiceOption = func(*ResourcesServiceServer)
@@ -37,8 +40,30 @@ func WithResourcePlugin(plugin common.ResourceService) ResourceServiceOption {
 	}
 }
 
+// WithPermissionTable - Records declared policies in the given table so they can be enforced at runtime
+func WithPermissionTable(permissions *PermissionTable) ResourceServiceOption {
+	return func(srv *ResourcesServiceServer) {
+		srv.permissions = permissions
+	}
+}
+
+// WithApiDefinitions - Records declared apis in the given definitions so their security can be enforced at runtime
+func WithApiDefinitions(apis *security.ApiDefinitions) ResourceServiceOption {
+	return func(srv *ResourcesServiceServer) {
+		srv.apis = apis
+	}
+}
+
 func (rs *ResourcesServiceServer) Declare(ctx context.Context, req *v1.ResourceDeclareRequest) (*v1.ResourceDeclareResponse, error) {
-	// Currently a no-op at runtime
+	if policy := req.GetPolicy(); policy != nil && rs.permissions != nil {
+		rs.permissions.Declare(policy)
+	}
+
+	if api := req.GetApi(); api != nil && rs.apis != nil {
+		rs.apis.Declare(req.GetResource().GetName(), api)
+	}
+
+	// Otherwise currently a no-op at runtime
 	// TODO: Implement a strategy pattern for resolving resources, by their declared resource name in nitric
 	return &v1.ResourceDeclareResponse{}, nil
 }
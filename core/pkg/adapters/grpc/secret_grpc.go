@@ -27,6 +27,7 @@ import (
 type SecretServer struct {
 	pb.UnimplementedSecretServiceServer
 	secretPlugin secret.SecretService
+	policy       PolicyEnforcer
 }
 
 func (s *SecretServer) checkPluginRegistered() error {
@@ -46,6 +47,10 @@ func (s *SecretServer) Put(ctx context.Context, req *pb.SecretPutRequest) (*pb.S
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Put", err)
 	}
 
+	if err := checkPermission(s.policy, "SecretService.Put", pb.Action_SecretPut, pb.ResourceType_Secret, req.GetSecret().GetName()); err != nil {
+		return nil, err
+	}
+
 	if r, err := s.secretPlugin.Put(ctx, &secret.Secret{
 		Name: req.GetSecret().GetName(),
 	}, req.GetValue()); err == nil {
@@ -71,6 +76,10 @@ func (s *SecretServer) Access(ctx context.Context, req *pb.SecretAccessRequest)
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "SecretService.Access", err)
 	}
 
+	if err := checkPermission(s.policy, "SecretService.Access", pb.Action_SecretAccess, pb.ResourceType_Secret, req.GetSecretVersion().GetSecret().GetName()); err != nil {
+		return nil, err
+	}
+
 	if s, err := s.secretPlugin.Access(ctx, &secret.SecretVersion{
 		Secret: &secret.Secret{
 			Name: req.GetSecretVersion().GetSecret().GetName(),
@@ -91,8 +100,11 @@ func (s *SecretServer) Access(ctx context.Context, req *pb.SecretAccessRequest)
 	}
 }
 
-func NewSecretServer(secretPlugin secret.SecretService) pb.SecretServiceServer {
+func NewSecretServer(secretPlugin secret.SecretService, opts ...ServiceServerOption) pb.SecretServiceServer {
+	o := newServiceServerOptions(opts)
+
 	return &SecretServer{
 		secretPlugin: secretPlugin,
+		policy:       o.policy,
 	}
 }
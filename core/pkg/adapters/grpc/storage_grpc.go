@@ -28,6 +28,7 @@ import (
 type StorageServiceServer struct {
 	pb.UnimplementedStorageServiceServer
 	storagePlugin storage.StorageService
+	policy        PolicyEnforcer
 }
 
 func (s *StorageServiceServer) checkPluginRegistered() error {
@@ -47,6 +48,10 @@ func (s *StorageServiceServer) Write(ctx context.Context, req *pb.StorageWriteRe
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Write", err)
 	}
 
+	if err := checkPermission(s.policy, "StorageService.Write", pb.Action_BucketFilePut, pb.ResourceType_Bucket, req.GetBucketName()); err != nil {
+		return nil, err
+	}
+
 	if err := s.storagePlugin.Write(ctx, req.GetBucketName(), req.GetKey(), req.GetBody()); err == nil {
 		return &pb.StorageWriteResponse{}, nil
 	} else {
@@ -63,6 +68,10 @@ func (s *StorageServiceServer) Read(ctx context.Context, req *pb.StorageReadRequ
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Read", err)
 	}
 
+	if err := checkPermission(s.policy, "StorageService.Read", pb.Action_BucketFileGet, pb.ResourceType_Bucket, req.GetBucketName()); err != nil {
+		return nil, err
+	}
+
 	if object, err := s.storagePlugin.Read(ctx, req.GetBucketName(), req.GetKey()); err == nil {
 		return &pb.StorageReadResponse{
 			Body: object,
@@ -81,6 +90,10 @@ func (s *StorageServiceServer) Delete(ctx context.Context, req *pb.StorageDelete
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.Delete", err)
 	}
 
+	if err := checkPermission(s.policy, "StorageService.Delete", pb.Action_BucketFileDelete, pb.ResourceType_Bucket, req.GetBucketName()); err != nil {
+		return nil, err
+	}
+
 	if err := s.storagePlugin.Delete(ctx, req.GetBucketName(), req.GetKey()); err == nil {
 		return &pb.StorageDeleteResponse{}, nil
 	} else {
@@ -112,6 +125,16 @@ func (s *StorageServiceServer) PreSignUrl(ctx context.Context, req *pb.StoragePr
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.PreSignUrl", err)
 	}
 
+	// Presigned URLs grant the same access as performing the operation directly
+	action := pb.Action_BucketFileGet
+	if intendedOp == storage.WRITE {
+		action = pb.Action_BucketFilePut
+	}
+
+	if err := checkPermission(s.policy, "StorageService.PreSignUrl", action, pb.ResourceType_Bucket, req.GetBucketName()); err != nil {
+		return nil, err
+	}
+
 	if url, err := s.storagePlugin.PreSignUrl(ctx, req.GetBucketName(), req.GetKey(), intendedOp, req.GetExpiry()); err == nil {
 		return &pb.StoragePreSignUrlResponse{
 			Url: url,
@@ -130,6 +153,10 @@ func (s *StorageServiceServer) ListFiles(ctx context.Context, req *pb.StorageLis
 		return nil, newGrpcErrorWithCode(codes.InvalidArgument, "StorageService.ListFiles", err)
 	}
 
+	if err := checkPermission(s.policy, "StorageService.ListFiles", pb.Action_BucketFileList, pb.ResourceType_Bucket, req.GetBucketName()); err != nil {
+		return nil, err
+	}
+
 	if files, err := s.storagePlugin.ListFiles(ctx, req.BucketName); err == nil {
 		pbFiles := make([]*pb.File, 0, len(files))
 
@@ -147,8 +174,11 @@ func (s *StorageServiceServer) ListFiles(ctx context.Context, req *pb.StorageLis
 	}
 }
 
-func NewStorageServiceServer(storagePlugin storage.StorageService) pb.StorageServiceServer {
+func NewStorageServiceServer(storagePlugin storage.StorageService, opts ...ServiceServerOption) pb.StorageServiceServer {
+	o := newServiceServerOptions(opts)
+
 	return &StorageServiceServer{
 		storagePlugin: storagePlugin,
+		policy:        o.policy,
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_websocket "github.com/nitrictech/nitric/core/mocks/websocket"
+	"github.com/nitrictech/nitric/core/pkg/adapters/grpc"
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+)
+
+var _ = Describe("GRPC Websocket", func() {
+	Context("Send", func() {
+		When("plugin not registered", func() {
+			ws := &grpc.WebsocketServer{}
+			resp, err := ws.Send(context.Background(), &v1.WebsocketSendRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Websocket plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request not valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockWS := mock_websocket.NewMockWebsocketService(g)
+			resp, err := grpc.NewWebsocketServer(mockWS).Send(context.Background(), &v1.WebsocketSendRequest{
+				Socket: "chat",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("invalid WebsocketSendRequest.ConnectionId"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockWS := mock_websocket.NewMockWebsocketService(g)
+
+			data := []byte("hello")
+			mockWS.EXPECT().Send(gomock.Any(), "chat", "conn-1", data).Return(nil)
+
+			resp, err := grpc.NewWebsocketServer(mockWS).Send(context.Background(), &v1.WebsocketSendRequest{
+				Socket:       "chat",
+				ConnectionId: "conn-1",
+				Data:         data,
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp).ShouldNot(BeNil())
+			})
+		})
+
+		When("the plugin returns an error", func() {
+			g := gomock.NewController(GinkgoT())
+			mockWS := mock_websocket.NewMockWebsocketService(g)
+
+			mockWS.EXPECT().Send(gomock.Any(), "chat", "conn-1", gomock.Any()).Return(errors.New("connection gone"))
+
+			resp, err := grpc.NewWebsocketServer(mockWS).Send(context.Background(), &v1.WebsocketSendRequest{
+				Socket:       "chat",
+				ConnectionId: "conn-1",
+			})
+
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("connection gone"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+	})
+
+	Context("Close", func() {
+		When("plugin not registered", func() {
+			ws := &grpc.WebsocketServer{}
+			resp, err := ws.Close(context.Background(), &v1.WebsocketCloseRequest{})
+			It("Should report an error", func() {
+				Expect(err.Error()).Should(ContainSubstring("Websocket plugin not registered"))
+				Expect(resp).Should(BeNil())
+			})
+		})
+
+		When("request is valid", func() {
+			g := gomock.NewController(GinkgoT())
+			mockWS := mock_websocket.NewMockWebsocketService(g)
+
+			mockWS.EXPECT().Close(gomock.Any(), "chat", "conn-1").Return(nil)
+
+			resp, err := grpc.NewWebsocketServer(mockWS).Close(context.Background(), &v1.WebsocketCloseRequest{
+				Socket:       "chat",
+				ConnectionId: "conn-1",
+			})
+
+			It("Should succeed", func() {
+				Expect(err).Should(BeNil())
+				Expect(resp).ShouldNot(BeNil())
+			})
+		})
+	})
+})
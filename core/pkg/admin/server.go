@@ -0,0 +1,145 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Check - returns an error describing why a component isn't ready, or nil if it is
+type Check func() error
+
+// Server - Serves membrane health, readiness and metrics for orchestrators and scrapers, separately from the gateway
+type Server struct {
+	address string
+	server  *http.Server
+
+	checksLock sync.RWMutex
+	checks     map[string]Check
+
+	metrics http.Handler
+}
+
+type ServerOption = func(*Server)
+
+// WithMetrics - Serves the given metrics handler on /metrics
+func WithMetrics(handler http.Handler) ServerOption {
+	return func(s *Server) {
+		s.metrics = handler
+	}
+}
+
+// Address - the address the admin endpoints are served on
+func (s *Server) Address() string {
+	return s.address
+}
+
+// AddReadinessCheck - Adds a named check that must pass for /readyz to report ready
+func (s *Server) AddReadinessCheck(name string, check Check) {
+	s.checksLock.Lock()
+	defer s.checksLock.Unlock()
+
+	s.checks[name] = check
+}
+
+// failingChecks - runs the readiness checks, returning a description of each failure in name order
+func (s *Server) failingChecks() []string {
+	s.checksLock.RLock()
+	defer s.checksLock.RUnlock()
+
+	failures := []string{}
+	for name, check := range s.checks {
+		if err := check(); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+
+	sort.Strings(failures)
+
+	return failures
+}
+
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+func (s *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	if failures := s.failingChecks(); len(failures) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(strings.Join(failures, "\n")))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// Handler - the admin endpoints
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.healthz)
+	mux.HandleFunc("/readyz", s.readyz)
+
+	if s.metrics != nil {
+		mux.Handle("/metrics", s.metrics)
+	}
+
+	return mux
+}
+
+// Start - Listens on the admin address, blocking until the server is stopped
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("could not listen on configured admin address: %w", err)
+	}
+
+	if err := s.server.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) Stop() error {
+	return s.server.Shutdown(context.Background())
+}
+
+// New - Creates a new admin server for the given address
+func New(address string, opts ...ServerOption) *Server {
+	s := &Server{
+		address: address,
+		checks:  make(map[string]Check),
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.server = &http.Server{
+		Handler: s.Handler(),
+	}
+
+	return s
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/admin"
+)
+
+func get(handler http.Handler, path string) (int, string) {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+
+	body, _ := io.ReadAll(rec.Result().Body)
+
+	return rec.Code, string(body)
+}
+
+var _ = Describe("Server", func() {
+	Context("/healthz", func() {
+		When("the membrane is running", func() {
+			srv := admin.New(":0")
+
+			It("should report healthy", func() {
+				code, _ := get(srv.Handler(), "/healthz")
+				Expect(code).To(Equal(200))
+			})
+		})
+	})
+
+	Context("/readyz", func() {
+		When("all readiness checks pass", func() {
+			srv := admin.New(":0")
+			srv.AddReadinessCheck("process", func() error { return nil })
+
+			It("should report ready", func() {
+				code, _ := get(srv.Handler(), "/readyz")
+				Expect(code).To(Equal(200))
+			})
+		})
+
+		When("a readiness check fails", func() {
+			srv := admin.New(":0")
+			srv.AddReadinessCheck("process", func() error { return nil })
+			srv.AddReadinessCheck("workers", func() error { return errors.New("waiting for workers") })
+
+			It("should report unavailable with the failing check", func() {
+				code, body := get(srv.Handler(), "/readyz")
+				Expect(code).To(Equal(503))
+				Expect(body).To(Equal("workers: waiting for workers"))
+			})
+		})
+	})
+
+	Context("/metrics", func() {
+		When("metrics are not enabled", func() {
+			srv := admin.New(":0")
+
+			It("should not be found", func() {
+				code, _ := get(srv.Handler(), "/metrics")
+				Expect(code).To(Equal(404))
+			})
+		})
+
+		When("metrics are enabled", func() {
+			srv := admin.New(":0", admin.WithMetrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("metrics"))
+			})))
+
+			It("should serve the metrics handler", func() {
+				code, body := get(srv.Handler(), "/metrics")
+				Expect(code).To(Equal(200))
+				Expect(body).To(Equal("metrics"))
+			})
+		})
+	})
+})
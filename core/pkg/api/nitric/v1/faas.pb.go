@@ -9,6 +9,8 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The lifecycle events of a websocket connection
+type WebsocketEvent int32
+
+const (
+	// A client is connecting, responding unsuccessfully rejects the connection
+	WebsocketEvent_Connect WebsocketEvent = 0
+	// A client has disconnected
+	WebsocketEvent_Disconnect WebsocketEvent = 1
+	// A client has sent a message
+	WebsocketEvent_Message WebsocketEvent = 2
+)
+
+// Enum value maps for WebsocketEvent.
+var (
+	WebsocketEvent_name = map[int32]string{
+		0: "Connect",
+		1: "Disconnect",
+		2: "Message",
+	}
+	WebsocketEvent_value = map[string]int32{
+		"Connect":    0,
+		"Disconnect": 1,
+		"Message":    2,
+	}
+)
+
+func (x WebsocketEvent) Enum() *WebsocketEvent {
+	p := new(WebsocketEvent)
+	*p = x
+	return p
+}
+
+func (x WebsocketEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebsocketEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_faas_v1_faas_proto_enumTypes[0].Descriptor()
+}
+
+func (WebsocketEvent) Type() protoreflect.EnumType {
+	return &file_faas_v1_faas_proto_enumTypes[0]
+}
+
+func (x WebsocketEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebsocketEvent.Descriptor instead.
+func (WebsocketEvent) EnumDescriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{0}
+}
+
 // Messages the client is able to send to the server
 type ClientMessage struct {
 	state         protoimpl.MessageState
@@ -32,6 +87,9 @@ type ClientMessage struct {
 	//
 	//	*ClientMessage_InitRequest
 	//	*ClientMessage_TriggerResponse
+	//	*ClientMessage_BodyChunk
+	//	*ClientMessage_ShutdownRequest
+	//	*ClientMessage_Pong
 	Content isClientMessage_Content `protobuf_oneof:"content"`
 }
 
@@ -95,6 +153,27 @@ func (x *ClientMessage) GetTriggerResponse() *TriggerResponse {
 	return nil
 }
 
+func (x *ClientMessage) GetBodyChunk() *BodyChunk {
+	if x, ok := x.GetContent().(*ClientMessage_BodyChunk); ok {
+		return x.BodyChunk
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetShutdownRequest() *ShutdownRequest {
+	if x, ok := x.GetContent().(*ClientMessage_ShutdownRequest); ok {
+		return x.ShutdownRequest
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetPong() *Pong {
+	if x, ok := x.GetContent().(*ClientMessage_Pong); ok {
+		return x.Pong
+	}
+	return nil
+}
+
 type isClientMessage_Content interface {
 	isClientMessage_Content()
 }
@@ -112,10 +191,33 @@ type ClientMessage_TriggerResponse struct {
 	TriggerResponse *TriggerResponse `protobuf:"bytes,3,opt,name=trigger_response,json=triggerResponse,proto3,oneof"`
 }
 
+type ClientMessage_BodyChunk struct {
+	// Client streaming the body of the response
+	// to the trigger with the same message ID
+	BodyChunk *BodyChunk `protobuf:"bytes,4,opt,name=body_chunk,json=bodyChunk,proto3,oneof"`
+}
+
+type ClientMessage_ShutdownRequest struct {
+	// Client requesting to leave the pool,
+	// e.g. in response to a DrainRequest
+	ShutdownRequest *ShutdownRequest `protobuf:"bytes,5,opt,name=shutdown_request,json=shutdownRequest,proto3,oneof"`
+}
+
+type ClientMessage_Pong struct {
+	// Client responding to a Ping
+	Pong *Pong `protobuf:"bytes,6,opt,name=pong,proto3,oneof"`
+}
+
 func (*ClientMessage_InitRequest) isClientMessage_Content() {}
 
 func (*ClientMessage_TriggerResponse) isClientMessage_Content() {}
 
+func (*ClientMessage_BodyChunk) isClientMessage_Content() {}
+
+func (*ClientMessage_ShutdownRequest) isClientMessage_Content() {}
+
+func (*ClientMessage_Pong) isClientMessage_Content() {}
+
 // Messages the server is able to send to the client
 type ServerMessage struct {
 	state         protoimpl.MessageState
@@ -128,6 +230,11 @@ type ServerMessage struct {
 	//
 	//	*ServerMessage_InitResponse
 	//	*ServerMessage_TriggerRequest
+	//	*ServerMessage_CancelRequest
+	//	*ServerMessage_BodyChunk
+	//	*ServerMessage_DrainRequest
+	//	*ServerMessage_ShutdownResponse
+	//	*ServerMessage_Ping
 	Content isServerMessage_Content `protobuf_oneof:"content"`
 }
 
@@ -191,6 +298,41 @@ func (x *ServerMessage) GetTriggerRequest() *TriggerRequest {
 	return nil
 }
 
+func (x *ServerMessage) GetCancelRequest() *CancelRequest {
+	if x, ok := x.GetContent().(*ServerMessage_CancelRequest); ok {
+		return x.CancelRequest
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetBodyChunk() *BodyChunk {
+	if x, ok := x.GetContent().(*ServerMessage_BodyChunk); ok {
+		return x.BodyChunk
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetDrainRequest() *DrainRequest {
+	if x, ok := x.GetContent().(*ServerMessage_DrainRequest); ok {
+		return x.DrainRequest
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetShutdownResponse() *ShutdownResponse {
+	if x, ok := x.GetContent().(*ServerMessage_ShutdownResponse); ok {
+		return x.ShutdownResponse
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetPing() *Ping {
+	if x, ok := x.GetContent().(*ServerMessage_Ping); ok {
+		return x.Ping
+	}
+	return nil
+}
+
 type isServerMessage_Content interface {
 	isServerMessage_Content()
 }
@@ -208,10 +350,370 @@ type ServerMessage_TriggerRequest struct {
 	TriggerRequest *TriggerRequest `protobuf:"bytes,3,opt,name=trigger_request,json=triggerRequest,proto3,oneof"`
 }
 
+type ServerMessage_CancelRequest struct {
+	// Server requesting client to
+	// abort processing of the trigger
+	// with the same message ID
+	CancelRequest *CancelRequest `protobuf:"bytes,4,opt,name=cancel_request,json=cancelRequest,proto3,oneof"`
+}
+
+type ServerMessage_BodyChunk struct {
+	// Server streaming the body of the request
+	// for the trigger with the same message ID
+	BodyChunk *BodyChunk `protobuf:"bytes,5,opt,name=body_chunk,json=bodyChunk,proto3,oneof"`
+}
+
+type ServerMessage_DrainRequest struct {
+	// Server notifying the client that
+	// it has stopped sending triggers
+	// and is waiting for the client to shut down
+	DrainRequest *DrainRequest `protobuf:"bytes,6,opt,name=drain_request,json=drainRequest,proto3,oneof"`
+}
+
+type ServerMessage_ShutdownResponse struct {
+	// Server confirming all triggers sent
+	// to the client have been responded to
+	// after a ShutdownRequest
+	ShutdownResponse *ShutdownResponse `protobuf:"bytes,7,opt,name=shutdown_response,json=shutdownResponse,proto3,oneof"`
+}
+
+type ServerMessage_Ping struct {
+	// Server checking the client is responsive,
+	// sent periodically to clients that support heartbeats
+	Ping *Ping `protobuf:"bytes,8,opt,name=ping,proto3,oneof"`
+}
+
 func (*ServerMessage_InitResponse) isServerMessage_Content() {}
 
 func (*ServerMessage_TriggerRequest) isServerMessage_Content() {}
 
+func (*ServerMessage_CancelRequest) isServerMessage_Content() {}
+
+func (*ServerMessage_BodyChunk) isServerMessage_Content() {}
+
+func (*ServerMessage_DrainRequest) isServerMessage_Content() {}
+
+func (*ServerMessage_ShutdownResponse) isServerMessage_Content() {}
+
+func (*ServerMessage_Ping) isServerMessage_Content() {}
+
+// A chunk of a streamed http body
+type BodyChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The data in this chunk
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// This is the final chunk of the body
+	End bool `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *BodyChunk) Reset() {
+	*x = BodyChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BodyChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyChunk) ProtoMessage() {}
+
+func (x *BodyChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyChunk.ProtoReflect.Descriptor instead.
+func (*BodyChunk) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BodyChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BodyChunk) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
+// The server has abandoned a trigger request,
+// e.g. it timed out or the upstream client disconnected
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason the request was cancelled
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The server is shutting down, it will send no new triggers.
+// The client should finish its outstanding triggers and send a ShutdownRequest
+type DrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason the server is draining its workers
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRequest) ProtoMessage() {}
+
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DrainRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The client is leaving the pool, the server will send no new triggers.
+// Once responses to all outstanding triggers have been received
+// the server sends a ShutdownResponse, after which the client may close the stream
+type ShutdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownRequest) ProtoMessage() {}
+
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{5}
+}
+
+// Placeholder message
+type ShutdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResponse) ProtoMessage() {}
+
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{6}
+}
+
+// The server is checking the client is responsive,
+// the client must respond with a Pong with the same message ID
+type Ping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{7}
+}
+
+// Placeholder message
+type Pong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Pong) Reset() {
+	*x = Pong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pong) ProtoMessage() {}
+
+func (x *Pong) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
+func (*Pong) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{8}
+}
+
 type ApiWorkerScopes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,7 +725,7 @@ type ApiWorkerScopes struct {
 func (x *ApiWorkerScopes) Reset() {
 	*x = ApiWorkerScopes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[2]
+		mi := &file_faas_v1_faas_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +738,7 @@ func (x *ApiWorkerScopes) String() string {
 func (*ApiWorkerScopes) ProtoMessage() {}
 
 func (x *ApiWorkerScopes) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[2]
+	mi := &file_faas_v1_faas_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +751,7 @@ func (x *ApiWorkerScopes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiWorkerScopes.ProtoReflect.Descriptor instead.
 func (*ApiWorkerScopes) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{2}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ApiWorkerScopes) GetScopes() []string {
@@ -275,7 +777,7 @@ type ApiWorkerOptions struct {
 func (x *ApiWorkerOptions) Reset() {
 	*x = ApiWorkerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[3]
+		mi := &file_faas_v1_faas_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -288,7 +790,7 @@ func (x *ApiWorkerOptions) String() string {
 func (*ApiWorkerOptions) ProtoMessage() {}
 
 func (x *ApiWorkerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[3]
+	mi := &file_faas_v1_faas_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +803,7 @@ func (x *ApiWorkerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiWorkerOptions.ProtoReflect.Descriptor instead.
 func (*ApiWorkerOptions) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{3}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ApiWorkerOptions) GetSecurity() map[string]*ApiWorkerScopes {
@@ -332,7 +834,7 @@ type ApiWorker struct {
 func (x *ApiWorker) Reset() {
 	*x = ApiWorker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[4]
+		mi := &file_faas_v1_faas_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +847,7 @@ func (x *ApiWorker) String() string {
 func (*ApiWorker) ProtoMessage() {}
 
 func (x *ApiWorker) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[4]
+	mi := &file_faas_v1_faas_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +860,7 @@ func (x *ApiWorker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiWorker.ProtoReflect.Descriptor instead.
 func (*ApiWorker) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{4}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ApiWorker) GetApi() string {
@@ -400,7 +902,7 @@ type SubscriptionWorker struct {
 func (x *SubscriptionWorker) Reset() {
 	*x = SubscriptionWorker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[5]
+		mi := &file_faas_v1_faas_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +915,7 @@ func (x *SubscriptionWorker) String() string {
 func (*SubscriptionWorker) ProtoMessage() {}
 
 func (x *SubscriptionWorker) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[5]
+	mi := &file_faas_v1_faas_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,14 +928,71 @@ func (x *SubscriptionWorker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionWorker.ProtoReflect.Descriptor instead.
 func (*SubscriptionWorker) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{5}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SubscriptionWorker) GetTopic() string {
 	if x != nil {
-		return x.Topic
+		return x.Topic
+	}
+	return ""
+}
+
+type WebsocketWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nitric name of the socket
+	Socket string `protobuf:"bytes,1,opt,name=socket,proto3" json:"socket,omitempty"`
+	// The connection event this worker handles
+	Event WebsocketEvent `protobuf:"varint,2,opt,name=event,proto3,enum=nitric.faas.v1.WebsocketEvent" json:"event,omitempty"`
+}
+
+func (x *WebsocketWorker) Reset() {
+	*x = WebsocketWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebsocketWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketWorker) ProtoMessage() {}
+
+func (x *WebsocketWorker) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsocketWorker.ProtoReflect.Descriptor instead.
+func (*WebsocketWorker) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebsocketWorker) GetSocket() string {
+	if x != nil {
+		return x.Socket
+	}
+	return ""
+}
+
+func (x *WebsocketWorker) GetEvent() WebsocketEvent {
+	if x != nil {
+		return x.Event
 	}
-	return ""
+	return WebsocketEvent_Connect
 }
 
 type ScheduleWorker struct {
@@ -452,7 +1011,7 @@ type ScheduleWorker struct {
 func (x *ScheduleWorker) Reset() {
 	*x = ScheduleWorker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[6]
+		mi := &file_faas_v1_faas_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +1024,7 @@ func (x *ScheduleWorker) String() string {
 func (*ScheduleWorker) ProtoMessage() {}
 
 func (x *ScheduleWorker) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[6]
+	mi := &file_faas_v1_faas_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +1037,7 @@ func (x *ScheduleWorker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleWorker.ProtoReflect.Descriptor instead.
 func (*ScheduleWorker) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{6}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ScheduleWorker) GetKey() string {
@@ -536,7 +1095,7 @@ type ScheduleRate struct {
 func (x *ScheduleRate) Reset() {
 	*x = ScheduleRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[7]
+		mi := &file_faas_v1_faas_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -549,7 +1108,7 @@ func (x *ScheduleRate) String() string {
 func (*ScheduleRate) ProtoMessage() {}
 
 func (x *ScheduleRate) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[7]
+	mi := &file_faas_v1_faas_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +1121,7 @@ func (x *ScheduleRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleRate.ProtoReflect.Descriptor instead.
 func (*ScheduleRate) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{7}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ScheduleRate) GetRate() string {
@@ -583,7 +1142,7 @@ type ScheduleCron struct {
 func (x *ScheduleCron) Reset() {
 	*x = ScheduleCron{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[8]
+		mi := &file_faas_v1_faas_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -596,7 +1155,7 @@ func (x *ScheduleCron) String() string {
 func (*ScheduleCron) ProtoMessage() {}
 
 func (x *ScheduleCron) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[8]
+	mi := &file_faas_v1_faas_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +1168,7 @@ func (x *ScheduleCron) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleCron.ProtoReflect.Descriptor instead.
 func (*ScheduleCron) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{8}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ScheduleCron) GetCron() string {
@@ -627,6 +1186,19 @@ type InitRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The worker supports streamed http bodies,
+	// otherwise bodies are always sent in full
+	BodyStreaming bool `protobuf:"varint,1,opt,name=body_streaming,json=bodyStreaming,proto3" json:"body_streaming,omitempty"`
+	// The maximum number of triggers the worker can handle concurrently,
+	// triggers beyond this are queued or routed to another worker.
+	// Unlimited if 0
+	MaxConcurrency uint32 `protobuf:"varint,2,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// The worker responds to Ping messages with a Pong,
+	// it will be removed from the pool if it stops responding
+	Heartbeat bool `protobuf:"varint,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// The worker handles schedules as ScheduleTriggerContext triggers,
+	// otherwise they are sent as topic triggers to the topic named after the schedule
+	ScheduleTriggers bool `protobuf:"varint,4,opt,name=schedule_triggers,json=scheduleTriggers,proto3" json:"schedule_triggers,omitempty"`
 	// The type of worker we are registering
 	//
 	// Types that are assignable to Worker:
@@ -634,13 +1206,14 @@ type InitRequest struct {
 	//	*InitRequest_Api
 	//	*InitRequest_Subscription
 	//	*InitRequest_Schedule
+	//	*InitRequest_Websocket
 	Worker isInitRequest_Worker `protobuf_oneof:"Worker"`
 }
 
 func (x *InitRequest) Reset() {
 	*x = InitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[9]
+		mi := &file_faas_v1_faas_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +1226,7 @@ func (x *InitRequest) String() string {
 func (*InitRequest) ProtoMessage() {}
 
 func (x *InitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[9]
+	mi := &file_faas_v1_faas_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +1239,35 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
 func (*InitRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{9}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InitRequest) GetBodyStreaming() bool {
+	if x != nil {
+		return x.BodyStreaming
+	}
+	return false
+}
+
+func (x *InitRequest) GetMaxConcurrency() uint32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *InitRequest) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+func (x *InitRequest) GetScheduleTriggers() bool {
+	if x != nil {
+		return x.ScheduleTriggers
+	}
+	return false
 }
 
 func (m *InitRequest) GetWorker() isInitRequest_Worker {
@@ -697,6 +1298,13 @@ func (x *InitRequest) GetSchedule() *ScheduleWorker {
 	return nil
 }
 
+func (x *InitRequest) GetWebsocket() *WebsocketWorker {
+	if x, ok := x.GetWorker().(*InitRequest_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
 type isInitRequest_Worker interface {
 	isInitRequest_Worker()
 }
@@ -713,12 +1321,18 @@ type InitRequest_Schedule struct {
 	Schedule *ScheduleWorker `protobuf:"bytes,12,opt,name=schedule,proto3,oneof"`
 }
 
+type InitRequest_Websocket struct {
+	Websocket *WebsocketWorker `protobuf:"bytes,13,opt,name=websocket,proto3,oneof"`
+}
+
 func (*InitRequest_Api) isInitRequest_Worker() {}
 
 func (*InitRequest_Subscription) isInitRequest_Worker() {}
 
 func (*InitRequest_Schedule) isInitRequest_Worker() {}
 
+func (*InitRequest_Websocket) isInitRequest_Worker() {}
+
 // Placeholder message
 type InitResponse struct {
 	state         protoimpl.MessageState
@@ -729,7 +1343,7 @@ type InitResponse struct {
 func (x *InitResponse) Reset() {
 	*x = InitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[10]
+		mi := &file_faas_v1_faas_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -742,7 +1356,7 @@ func (x *InitResponse) String() string {
 func (*InitResponse) ProtoMessage() {}
 
 func (x *InitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[10]
+	mi := &file_faas_v1_faas_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1369,7 @@ func (x *InitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
 func (*InitResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{10}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{18}
 }
 
 type TraceContext struct {
@@ -769,7 +1383,7 @@ type TraceContext struct {
 func (x *TraceContext) Reset() {
 	*x = TraceContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[11]
+		mi := &file_faas_v1_faas_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -782,7 +1396,7 @@ func (x *TraceContext) String() string {
 func (*TraceContext) ProtoMessage() {}
 
 func (x *TraceContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[11]
+	mi := &file_faas_v1_faas_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +1409,7 @@ func (x *TraceContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TraceContext.ProtoReflect.Descriptor instead.
 func (*TraceContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{11}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TraceContext) GetValues() map[string]string {
@@ -827,13 +1441,15 @@ type TriggerRequest struct {
 	//
 	//	*TriggerRequest_Http
 	//	*TriggerRequest_Topic
+	//	*TriggerRequest_Websocket
+	//	*TriggerRequest_Schedule
 	Context isTriggerRequest_Context `protobuf_oneof:"context"`
 }
 
 func (x *TriggerRequest) Reset() {
 	*x = TriggerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[12]
+		mi := &file_faas_v1_faas_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +1462,7 @@ func (x *TriggerRequest) String() string {
 func (*TriggerRequest) ProtoMessage() {}
 
 func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[12]
+	mi := &file_faas_v1_faas_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1475,7 @@ func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{12}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TriggerRequest) GetData() []byte {
@@ -904,6 +1520,20 @@ func (x *TriggerRequest) GetTopic() *TopicTriggerContext {
 	return nil
 }
 
+func (x *TriggerRequest) GetWebsocket() *WebsocketTriggerContext {
+	if x, ok := x.GetContext().(*TriggerRequest_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetSchedule() *ScheduleTriggerContext {
+	if x, ok := x.GetContext().(*TriggerRequest_Schedule); ok {
+		return x.Schedule
+	}
+	return nil
+}
+
 type isTriggerRequest_Context interface {
 	isTriggerRequest_Context()
 }
@@ -916,10 +1546,22 @@ type TriggerRequest_Topic struct {
 	Topic *TopicTriggerContext `protobuf:"bytes,4,opt,name=topic,proto3,oneof"`
 }
 
+type TriggerRequest_Websocket struct {
+	Websocket *WebsocketTriggerContext `protobuf:"bytes,5,opt,name=websocket,proto3,oneof"`
+}
+
+type TriggerRequest_Schedule struct {
+	Schedule *ScheduleTriggerContext `protobuf:"bytes,6,opt,name=schedule,proto3,oneof"`
+}
+
 func (*TriggerRequest_Http) isTriggerRequest_Context() {}
 
 func (*TriggerRequest_Topic) isTriggerRequest_Context() {}
 
+func (*TriggerRequest_Websocket) isTriggerRequest_Context() {}
+
+func (*TriggerRequest_Schedule) isTriggerRequest_Context() {}
+
 type HeaderValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -931,7 +1573,7 @@ type HeaderValue struct {
 func (x *HeaderValue) Reset() {
 	*x = HeaderValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[13]
+		mi := &file_faas_v1_faas_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -944,7 +1586,7 @@ func (x *HeaderValue) String() string {
 func (*HeaderValue) ProtoMessage() {}
 
 func (x *HeaderValue) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[13]
+	mi := &file_faas_v1_faas_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1599,7 @@ func (x *HeaderValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeaderValue.ProtoReflect.Descriptor instead.
 func (*HeaderValue) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{13}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *HeaderValue) GetValue() []string {
@@ -978,7 +1620,7 @@ type QueryValue struct {
 func (x *QueryValue) Reset() {
 	*x = QueryValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[14]
+		mi := &file_faas_v1_faas_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -991,7 +1633,7 @@ func (x *QueryValue) String() string {
 func (*QueryValue) ProtoMessage() {}
 
 func (x *QueryValue) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[14]
+	mi := &file_faas_v1_faas_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1646,7 @@ func (x *QueryValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryValue.ProtoReflect.Descriptor instead.
 func (*QueryValue) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{14}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryValue) GetValue() []string {
@@ -1039,12 +1681,21 @@ type HttpTriggerContext struct {
 	QueryParams map[string]*QueryValue `protobuf:"bytes,6,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// HTTP Path parameters
 	PathParams map[string]string `protobuf:"bytes,7,rep,name=path_params,json=pathParams,proto3" json:"path_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Claims of the bearer token validated by the membrane for secured routes
+	Claims *structpb.Struct `protobuf:"bytes,8,opt,name=claims,proto3" json:"claims,omitempty"`
+	// The name of the API the request was received on
+	Api string `protobuf:"bytes,9,opt,name=api,proto3" json:"api,omitempty"`
+	// The security options of the route that matched the request
+	Options *ApiWorkerOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	// The request body follows in BodyChunk messages
+	// with the same ID as this request
+	StreamedBody bool `protobuf:"varint,11,opt,name=streamed_body,json=streamedBody,proto3" json:"streamed_body,omitempty"`
 }
 
 func (x *HttpTriggerContext) Reset() {
 	*x = HttpTriggerContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[15]
+		mi := &file_faas_v1_faas_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1057,7 +1708,7 @@ func (x *HttpTriggerContext) String() string {
 func (*HttpTriggerContext) ProtoMessage() {}
 
 func (x *HttpTriggerContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[15]
+	mi := &file_faas_v1_faas_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1721,7 @@ func (x *HttpTriggerContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpTriggerContext.ProtoReflect.Descriptor instead.
 func (*HttpTriggerContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{15}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *HttpTriggerContext) GetMethod() string {
@@ -1124,6 +1775,34 @@ func (x *HttpTriggerContext) GetPathParams() map[string]string {
 	return nil
 }
 
+func (x *HttpTriggerContext) GetClaims() *structpb.Struct {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *HttpTriggerContext) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *HttpTriggerContext) GetOptions() *ApiWorkerOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *HttpTriggerContext) GetStreamedBody() bool {
+	if x != nil {
+		return x.StreamedBody
+	}
+	return false
+}
+
 type TopicTriggerContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1131,12 +1810,24 @@ type TopicTriggerContext struct {
 
 	// The topic the message was published for
 	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The ID of the event, the same for each delivery of the event,
+	// e.g. for deduplicating redelivered events
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of the event payload, as set by the publisher
+	PayloadType string `protobuf:"bytes,3,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	// The attributes of the message carrying the event
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The time the event was published
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// The delivery attempt of the event, starting at 1,
+	// 0 if the source does not report delivery attempts
+	DeliveryAttempt uint32 `protobuf:"varint,6,opt,name=delivery_attempt,json=deliveryAttempt,proto3" json:"delivery_attempt,omitempty"`
 }
 
 func (x *TopicTriggerContext) Reset() {
 	*x = TopicTriggerContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[16]
+		mi := &file_faas_v1_faas_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1149,7 +1840,7 @@ func (x *TopicTriggerContext) String() string {
 func (*TopicTriggerContext) ProtoMessage() {}
 
 func (x *TopicTriggerContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[16]
+	mi := &file_faas_v1_faas_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1853,7 @@ func (x *TopicTriggerContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicTriggerContext.ProtoReflect.Descriptor instead.
 func (*TopicTriggerContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{16}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TopicTriggerContext) GetTopic() string {
@@ -1172,6 +1863,192 @@ func (x *TopicTriggerContext) GetTopic() string {
 	return ""
 }
 
+func (x *TopicTriggerContext) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicTriggerContext) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *TopicTriggerContext) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *TopicTriggerContext) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *TopicTriggerContext) GetDeliveryAttempt() uint32 {
+	if x != nil {
+		return x.DeliveryAttempt
+	}
+	return 0
+}
+
+type WebsocketTriggerContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nitric name of the socket the event occurred on
+	Socket string `protobuf:"bytes,1,opt,name=socket,proto3" json:"socket,omitempty"`
+	// The connection event
+	Event WebsocketEvent `protobuf:"varint,2,opt,name=event,proto3,enum=nitric.faas.v1.WebsocketEvent" json:"event,omitempty"`
+	// The ID of the connection, used to send messages to the client
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The query params of the connection request
+	QueryParams map[string]*QueryValue `protobuf:"bytes,4,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WebsocketTriggerContext) Reset() {
+	*x = WebsocketTriggerContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebsocketTriggerContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketTriggerContext) ProtoMessage() {}
+
+func (x *WebsocketTriggerContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsocketTriggerContext.ProtoReflect.Descriptor instead.
+func (*WebsocketTriggerContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WebsocketTriggerContext) GetSocket() string {
+	if x != nil {
+		return x.Socket
+	}
+	return ""
+}
+
+func (x *WebsocketTriggerContext) GetEvent() WebsocketEvent {
+	if x != nil {
+		return x.Event
+	}
+	return WebsocketEvent_Connect
+}
+
+func (x *WebsocketTriggerContext) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *WebsocketTriggerContext) GetQueryParams() map[string]*QueryValue {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+type ScheduleTriggerContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the schedule that fired
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The time the schedule was due to fire
+	ScheduledTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	// The time the schedule fired,
+	// later than the scheduled time by any jitter or delay
+	FireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
+	// The rate or cron expression the schedule was declared with, e.g. "5 minutes"
+	Cadence string `protobuf:"bytes,4,opt,name=cadence,proto3" json:"cadence,omitempty"`
+}
+
+func (x *ScheduleTriggerContext) Reset() {
+	*x = ScheduleTriggerContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleTriggerContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTriggerContext) ProtoMessage() {}
+
+func (x *ScheduleTriggerContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTriggerContext.ProtoReflect.Descriptor instead.
+func (*ScheduleTriggerContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScheduleTriggerContext) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ScheduleTriggerContext) GetScheduledTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *ScheduleTriggerContext) GetFireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireTime
+	}
+	return nil
+}
+
+func (x *ScheduleTriggerContext) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
 // The worker has successfully processed a trigger
 type TriggerResponse struct {
 	state         protoimpl.MessageState
@@ -1188,13 +2065,15 @@ type TriggerResponse struct {
 	//
 	//	*TriggerResponse_Http
 	//	*TriggerResponse_Topic
+	//	*TriggerResponse_Websocket
+	//	*TriggerResponse_Schedule
 	Context isTriggerResponse_Context `protobuf_oneof:"context"`
 }
 
 func (x *TriggerResponse) Reset() {
 	*x = TriggerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[17]
+		mi := &file_faas_v1_faas_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1207,7 +2086,7 @@ func (x *TriggerResponse) String() string {
 func (*TriggerResponse) ProtoMessage() {}
 
 func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[17]
+	mi := &file_faas_v1_faas_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +2099,7 @@ func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerResponse.ProtoReflect.Descriptor instead.
 func (*TriggerResponse) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{17}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TriggerResponse) GetData() []byte {
@@ -1251,6 +2130,20 @@ func (x *TriggerResponse) GetTopic() *TopicResponseContext {
 	return nil
 }
 
+func (x *TriggerResponse) GetWebsocket() *WebsocketResponseContext {
+	if x, ok := x.GetContext().(*TriggerResponse_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetSchedule() *ScheduleResponseContext {
+	if x, ok := x.GetContext().(*TriggerResponse_Schedule); ok {
+		return x.Schedule
+	}
+	return nil
+}
+
 type isTriggerResponse_Context interface {
 	isTriggerResponse_Context()
 }
@@ -1265,10 +2158,24 @@ type TriggerResponse_Topic struct {
 	Topic *TopicResponseContext `protobuf:"bytes,11,opt,name=topic,proto3,oneof"`
 }
 
+type TriggerResponse_Websocket struct {
+	// response to a websocket trigger
+	Websocket *WebsocketResponseContext `protobuf:"bytes,12,opt,name=websocket,proto3,oneof"`
+}
+
+type TriggerResponse_Schedule struct {
+	// response to a schedule trigger
+	Schedule *ScheduleResponseContext `protobuf:"bytes,13,opt,name=schedule,proto3,oneof"`
+}
+
 func (*TriggerResponse_Http) isTriggerResponse_Context() {}
 
 func (*TriggerResponse_Topic) isTriggerResponse_Context() {}
 
+func (*TriggerResponse_Websocket) isTriggerResponse_Context() {}
+
+func (*TriggerResponse_Schedule) isTriggerResponse_Context() {}
+
 // Specific HttpResponse message
 // Note this does not have to be handled by the
 // User at all but they will have the option of control
@@ -1287,12 +2194,15 @@ type HttpResponseContext struct {
 	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	// HTTP response headers
 	Headers map[string]*HeaderValue `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The rest of the response body follows data
+	// in BodyChunk messages with the same ID as this response
+	StreamedBody bool `protobuf:"varint,4,opt,name=streamed_body,json=streamedBody,proto3" json:"streamed_body,omitempty"`
 }
 
 func (x *HttpResponseContext) Reset() {
 	*x = HttpResponseContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[18]
+		mi := &file_faas_v1_faas_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1305,7 +2215,7 @@ func (x *HttpResponseContext) String() string {
 func (*HttpResponseContext) ProtoMessage() {}
 
 func (x *HttpResponseContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[18]
+	mi := &file_faas_v1_faas_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,7 +2228,7 @@ func (x *HttpResponseContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpResponseContext.ProtoReflect.Descriptor instead.
 func (*HttpResponseContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{18}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{28}
 }
 
 // Deprecated: Do not use.
@@ -1343,6 +2253,13 @@ func (x *HttpResponseContext) GetHeaders() map[string]*HeaderValue {
 	return nil
 }
 
+func (x *HttpResponseContext) GetStreamedBody() bool {
+	if x != nil {
+		return x.StreamedBody
+	}
+	return false
+}
+
 // Specific event response message
 // We do not accept responses for events
 // only whether or not they were successfully processed
@@ -1358,7 +2275,7 @@ type TopicResponseContext struct {
 func (x *TopicResponseContext) Reset() {
 	*x = TopicResponseContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_faas_v1_faas_proto_msgTypes[19]
+		mi := &file_faas_v1_faas_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1371,7 +2288,7 @@ func (x *TopicResponseContext) String() string {
 func (*TopicResponseContext) ProtoMessage() {}
 
 func (x *TopicResponseContext) ProtoReflect() protoreflect.Message {
-	mi := &file_faas_v1_faas_proto_msgTypes[19]
+	mi := &file_faas_v1_faas_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +2301,7 @@ func (x *TopicResponseContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicResponseContext.ProtoReflect.Descriptor instead.
 func (*TopicResponseContext) Descriptor() ([]byte, []int) {
-	return file_faas_v1_faas_proto_rawDescGZIP(), []int{19}
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TopicResponseContext) GetSuccess() bool {
@@ -1394,217 +2311,475 @@ func (x *TopicResponseContext) GetSuccess() bool {
 	return false
 }
 
+// Specific websocket response message
+type WebsocketResponseContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Success status of the handled event,
+	// an unsuccessful connect event rejects the connection
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *WebsocketResponseContext) Reset() {
+	*x = WebsocketResponseContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebsocketResponseContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketResponseContext) ProtoMessage() {}
+
+func (x *WebsocketResponseContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsocketResponseContext.ProtoReflect.Descriptor instead.
+func (*WebsocketResponseContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WebsocketResponseContext) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ScheduleResponseContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Success status of the handled schedule
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ScheduleResponseContext) Reset() {
+	*x = ScheduleResponseContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_faas_v1_faas_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleResponseContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleResponseContext) ProtoMessage() {}
+
+func (x *ScheduleResponseContext) ProtoReflect() protoreflect.Message {
+	mi := &file_faas_v1_faas_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleResponseContext.ProtoReflect.Descriptor instead.
+func (*ScheduleResponseContext) Descriptor() ([]byte, []int) {
+	return file_faas_v1_faas_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduleResponseContext) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_faas_v1_faas_proto protoreflect.FileDescriptor
 
 var file_faas_v1_faas_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x66, 0x61, 0x61, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61,
-	0x73, 0x2e, 0x76, 0x31, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
-	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x29,
-	0x0a, 0x0f, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x10, 0x41, 0x70,
-	0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a,
-	0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x5c, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x2a, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x95, 0x01, 0x0a, 0x0e,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x32, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65,
-	0x6e, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x0b,
-	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x61,
-	0x70, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
-	0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x0e, 0x0a, 0x0c,
-	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a,
-	0x0c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
-	0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x4c, 0x0a, 0x10, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f,
+	0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x04, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0c, 0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
+	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x43, 0x0a, 0x0d,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4f, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x09,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x09, 0x42, 0x6f, 0x64,
+	0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x27, 0x0a, 0x0d,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x11, 0x0a,
+	0x0f, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x12, 0x0a, 0x10, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x22, 0x06, 0x0a, 0x04,
+	0x50, 0x6f, 0x6e, 0x67, 0x22, 0x29, 0x0a, 0x0f, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0xe9, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x5c, 0x0a,
+	0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x02, 0x0a, 0x0e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41,
-	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
-	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48,
-	0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xed, 0x06, 0x0a,
-	0x12, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09,
+	0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
 	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x57, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
-	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x64, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x22, 0x5f, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
+	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x22,
+	0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72,
+	0x6f, 0x6e, 0x22, 0xaa, 0x03, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x6f, 0x64, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a,
+	0x03, 0x61, 0x70, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22,
+	0x0e, 0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8b, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x40, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x03,
+	0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
 	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x49,
-	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x47, 0x0a, 0x09, 0x77,
+	0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91,
+	0x08, 0x0a, 0x12, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x57, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
 	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x5a, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
-	0x0f, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x13,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x39, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3c, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x69,
-	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xeb, 0x02, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x58, 0x0a,
-	0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x4a, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f,
+	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x64, 0x0a, 0x10, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
+	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64,
+	0x12, 0x49, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
 	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
+	0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61,
+	0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x3a, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0c, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69,
-	0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0x60, 0x0a, 0x0b, 0x46, 0x61, 0x61, 0x73, 0x53, 0x65, 0x72,
+	0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xdc, 0x02, 0x0a, 0x13, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xc5, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
+	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x5b, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x5a, 0x0a,
+	0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x69, 0x72,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xba, 0x02, 0x0a,
+	0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12,
+	0x3c, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x48, 0x0a,
+	0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65,
+	0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x69, 0x74, 0x72,
+	0x69, 0x63, 0x2e, 0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x13, 0x48, 0x74,
+	0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x58, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x66, 0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
+	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x4f,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66, 0x61,
+	0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x14,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x34,
+	0x0a, 0x18, 0x57, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x33, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2a, 0x3a, 0x0a, 0x0e, 0x57, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x10, 0x02, 0x32, 0x60, 0x0a, 0x0b, 0x46, 0x61, 0x61, 0x73, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x66,
 	0x61, 0x61, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
@@ -1632,74 +2807,115 @@ func file_faas_v1_faas_proto_rawDescGZIP() []byte {
 	return file_faas_v1_faas_proto_rawDescData
 }
 
-var file_faas_v1_faas_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_faas_v1_faas_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_faas_v1_faas_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_faas_v1_faas_proto_goTypes = []interface{}{
-	(*ClientMessage)(nil),        // 0: nitric.faas.v1.ClientMessage
-	(*ServerMessage)(nil),        // 1: nitric.faas.v1.ServerMessage
-	(*ApiWorkerScopes)(nil),      // 2: nitric.faas.v1.ApiWorkerScopes
-	(*ApiWorkerOptions)(nil),     // 3: nitric.faas.v1.ApiWorkerOptions
-	(*ApiWorker)(nil),            // 4: nitric.faas.v1.ApiWorker
-	(*SubscriptionWorker)(nil),   // 5: nitric.faas.v1.SubscriptionWorker
-	(*ScheduleWorker)(nil),       // 6: nitric.faas.v1.ScheduleWorker
-	(*ScheduleRate)(nil),         // 7: nitric.faas.v1.ScheduleRate
-	(*ScheduleCron)(nil),         // 8: nitric.faas.v1.ScheduleCron
-	(*InitRequest)(nil),          // 9: nitric.faas.v1.InitRequest
-	(*InitResponse)(nil),         // 10: nitric.faas.v1.InitResponse
-	(*TraceContext)(nil),         // 11: nitric.faas.v1.TraceContext
-	(*TriggerRequest)(nil),       // 12: nitric.faas.v1.TriggerRequest
-	(*HeaderValue)(nil),          // 13: nitric.faas.v1.HeaderValue
-	(*QueryValue)(nil),           // 14: nitric.faas.v1.QueryValue
-	(*HttpTriggerContext)(nil),   // 15: nitric.faas.v1.HttpTriggerContext
-	(*TopicTriggerContext)(nil),  // 16: nitric.faas.v1.TopicTriggerContext
-	(*TriggerResponse)(nil),      // 17: nitric.faas.v1.TriggerResponse
-	(*HttpResponseContext)(nil),  // 18: nitric.faas.v1.HttpResponseContext
-	(*TopicResponseContext)(nil), // 19: nitric.faas.v1.TopicResponseContext
-	nil,                          // 20: nitric.faas.v1.ApiWorkerOptions.SecurityEntry
-	nil,                          // 21: nitric.faas.v1.TraceContext.ValuesEntry
-	nil,                          // 22: nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
-	nil,                          // 23: nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
-	nil,                          // 24: nitric.faas.v1.HttpTriggerContext.HeadersEntry
-	nil,                          // 25: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
-	nil,                          // 26: nitric.faas.v1.HttpTriggerContext.PathParamsEntry
-	nil,                          // 27: nitric.faas.v1.HttpResponseContext.HeadersOldEntry
-	nil,                          // 28: nitric.faas.v1.HttpResponseContext.HeadersEntry
+	(WebsocketEvent)(0),              // 0: nitric.faas.v1.WebsocketEvent
+	(*ClientMessage)(nil),            // 1: nitric.faas.v1.ClientMessage
+	(*ServerMessage)(nil),            // 2: nitric.faas.v1.ServerMessage
+	(*BodyChunk)(nil),                // 3: nitric.faas.v1.BodyChunk
+	(*CancelRequest)(nil),            // 4: nitric.faas.v1.CancelRequest
+	(*DrainRequest)(nil),             // 5: nitric.faas.v1.DrainRequest
+	(*ShutdownRequest)(nil),          // 6: nitric.faas.v1.ShutdownRequest
+	(*ShutdownResponse)(nil),         // 7: nitric.faas.v1.ShutdownResponse
+	(*Ping)(nil),                     // 8: nitric.faas.v1.Ping
+	(*Pong)(nil),                     // 9: nitric.faas.v1.Pong
+	(*ApiWorkerScopes)(nil),          // 10: nitric.faas.v1.ApiWorkerScopes
+	(*ApiWorkerOptions)(nil),         // 11: nitric.faas.v1.ApiWorkerOptions
+	(*ApiWorker)(nil),                // 12: nitric.faas.v1.ApiWorker
+	(*SubscriptionWorker)(nil),       // 13: nitric.faas.v1.SubscriptionWorker
+	(*WebsocketWorker)(nil),          // 14: nitric.faas.v1.WebsocketWorker
+	(*ScheduleWorker)(nil),           // 15: nitric.faas.v1.ScheduleWorker
+	(*ScheduleRate)(nil),             // 16: nitric.faas.v1.ScheduleRate
+	(*ScheduleCron)(nil),             // 17: nitric.faas.v1.ScheduleCron
+	(*InitRequest)(nil),              // 18: nitric.faas.v1.InitRequest
+	(*InitResponse)(nil),             // 19: nitric.faas.v1.InitResponse
+	(*TraceContext)(nil),             // 20: nitric.faas.v1.TraceContext
+	(*TriggerRequest)(nil),           // 21: nitric.faas.v1.TriggerRequest
+	(*HeaderValue)(nil),              // 22: nitric.faas.v1.HeaderValue
+	(*QueryValue)(nil),               // 23: nitric.faas.v1.QueryValue
+	(*HttpTriggerContext)(nil),       // 24: nitric.faas.v1.HttpTriggerContext
+	(*TopicTriggerContext)(nil),      // 25: nitric.faas.v1.TopicTriggerContext
+	(*WebsocketTriggerContext)(nil),  // 26: nitric.faas.v1.WebsocketTriggerContext
+	(*ScheduleTriggerContext)(nil),   // 27: nitric.faas.v1.ScheduleTriggerContext
+	(*TriggerResponse)(nil),          // 28: nitric.faas.v1.TriggerResponse
+	(*HttpResponseContext)(nil),      // 29: nitric.faas.v1.HttpResponseContext
+	(*TopicResponseContext)(nil),     // 30: nitric.faas.v1.TopicResponseContext
+	(*WebsocketResponseContext)(nil), // 31: nitric.faas.v1.WebsocketResponseContext
+	(*ScheduleResponseContext)(nil),  // 32: nitric.faas.v1.ScheduleResponseContext
+	nil,                              // 33: nitric.faas.v1.ApiWorkerOptions.SecurityEntry
+	nil,                              // 34: nitric.faas.v1.TraceContext.ValuesEntry
+	nil,                              // 35: nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
+	nil,                              // 36: nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
+	nil,                              // 37: nitric.faas.v1.HttpTriggerContext.HeadersEntry
+	nil,                              // 38: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
+	nil,                              // 39: nitric.faas.v1.HttpTriggerContext.PathParamsEntry
+	nil,                              // 40: nitric.faas.v1.TopicTriggerContext.AttributesEntry
+	nil,                              // 41: nitric.faas.v1.WebsocketTriggerContext.QueryParamsEntry
+	nil,                              // 42: nitric.faas.v1.HttpResponseContext.HeadersOldEntry
+	nil,                              // 43: nitric.faas.v1.HttpResponseContext.HeadersEntry
+	(*structpb.Struct)(nil),          // 44: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),    // 45: google.protobuf.Timestamp
 }
 var file_faas_v1_faas_proto_depIdxs = []int32{
-	9,  // 0: nitric.faas.v1.ClientMessage.init_request:type_name -> nitric.faas.v1.InitRequest
-	17, // 1: nitric.faas.v1.ClientMessage.trigger_response:type_name -> nitric.faas.v1.TriggerResponse
-	10, // 2: nitric.faas.v1.ServerMessage.init_response:type_name -> nitric.faas.v1.InitResponse
-	12, // 3: nitric.faas.v1.ServerMessage.trigger_request:type_name -> nitric.faas.v1.TriggerRequest
-	20, // 4: nitric.faas.v1.ApiWorkerOptions.security:type_name -> nitric.faas.v1.ApiWorkerOptions.SecurityEntry
-	3,  // 5: nitric.faas.v1.ApiWorker.options:type_name -> nitric.faas.v1.ApiWorkerOptions
-	7,  // 6: nitric.faas.v1.ScheduleWorker.rate:type_name -> nitric.faas.v1.ScheduleRate
-	8,  // 7: nitric.faas.v1.ScheduleWorker.cron:type_name -> nitric.faas.v1.ScheduleCron
-	4,  // 8: nitric.faas.v1.InitRequest.api:type_name -> nitric.faas.v1.ApiWorker
-	5,  // 9: nitric.faas.v1.InitRequest.subscription:type_name -> nitric.faas.v1.SubscriptionWorker
-	6,  // 10: nitric.faas.v1.InitRequest.schedule:type_name -> nitric.faas.v1.ScheduleWorker
-	21, // 11: nitric.faas.v1.TraceContext.values:type_name -> nitric.faas.v1.TraceContext.ValuesEntry
-	11, // 12: nitric.faas.v1.TriggerRequest.trace_context:type_name -> nitric.faas.v1.TraceContext
-	15, // 13: nitric.faas.v1.TriggerRequest.http:type_name -> nitric.faas.v1.HttpTriggerContext
-	16, // 14: nitric.faas.v1.TriggerRequest.topic:type_name -> nitric.faas.v1.TopicTriggerContext
-	22, // 15: nitric.faas.v1.HttpTriggerContext.headers_old:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
-	23, // 16: nitric.faas.v1.HttpTriggerContext.query_params_old:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
-	24, // 17: nitric.faas.v1.HttpTriggerContext.headers:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersEntry
-	25, // 18: nitric.faas.v1.HttpTriggerContext.query_params:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
-	26, // 19: nitric.faas.v1.HttpTriggerContext.path_params:type_name -> nitric.faas.v1.HttpTriggerContext.PathParamsEntry
-	18, // 20: nitric.faas.v1.TriggerResponse.http:type_name -> nitric.faas.v1.HttpResponseContext
-	19, // 21: nitric.faas.v1.TriggerResponse.topic:type_name -> nitric.faas.v1.TopicResponseContext
-	27, // 22: nitric.faas.v1.HttpResponseContext.headers_old:type_name -> nitric.faas.v1.HttpResponseContext.HeadersOldEntry
-	28, // 23: nitric.faas.v1.HttpResponseContext.headers:type_name -> nitric.faas.v1.HttpResponseContext.HeadersEntry
-	2,  // 24: nitric.faas.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.faas.v1.ApiWorkerScopes
-	13, // 25: nitric.faas.v1.HttpTriggerContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
-	14, // 26: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry.value:type_name -> nitric.faas.v1.QueryValue
-	13, // 27: nitric.faas.v1.HttpResponseContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
-	0,  // 28: nitric.faas.v1.FaasService.TriggerStream:input_type -> nitric.faas.v1.ClientMessage
-	1,  // 29: nitric.faas.v1.FaasService.TriggerStream:output_type -> nitric.faas.v1.ServerMessage
-	29, // [29:30] is the sub-list for method output_type
-	28, // [28:29] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	18, // 0: nitric.faas.v1.ClientMessage.init_request:type_name -> nitric.faas.v1.InitRequest
+	28, // 1: nitric.faas.v1.ClientMessage.trigger_response:type_name -> nitric.faas.v1.TriggerResponse
+	3,  // 2: nitric.faas.v1.ClientMessage.body_chunk:type_name -> nitric.faas.v1.BodyChunk
+	6,  // 3: nitric.faas.v1.ClientMessage.shutdown_request:type_name -> nitric.faas.v1.ShutdownRequest
+	9,  // 4: nitric.faas.v1.ClientMessage.pong:type_name -> nitric.faas.v1.Pong
+	19, // 5: nitric.faas.v1.ServerMessage.init_response:type_name -> nitric.faas.v1.InitResponse
+	21, // 6: nitric.faas.v1.ServerMessage.trigger_request:type_name -> nitric.faas.v1.TriggerRequest
+	4,  // 7: nitric.faas.v1.ServerMessage.cancel_request:type_name -> nitric.faas.v1.CancelRequest
+	3,  // 8: nitric.faas.v1.ServerMessage.body_chunk:type_name -> nitric.faas.v1.BodyChunk
+	5,  // 9: nitric.faas.v1.ServerMessage.drain_request:type_name -> nitric.faas.v1.DrainRequest
+	7,  // 10: nitric.faas.v1.ServerMessage.shutdown_response:type_name -> nitric.faas.v1.ShutdownResponse
+	8,  // 11: nitric.faas.v1.ServerMessage.ping:type_name -> nitric.faas.v1.Ping
+	33, // 12: nitric.faas.v1.ApiWorkerOptions.security:type_name -> nitric.faas.v1.ApiWorkerOptions.SecurityEntry
+	11, // 13: nitric.faas.v1.ApiWorker.options:type_name -> nitric.faas.v1.ApiWorkerOptions
+	0,  // 14: nitric.faas.v1.WebsocketWorker.event:type_name -> nitric.faas.v1.WebsocketEvent
+	16, // 15: nitric.faas.v1.ScheduleWorker.rate:type_name -> nitric.faas.v1.ScheduleRate
+	17, // 16: nitric.faas.v1.ScheduleWorker.cron:type_name -> nitric.faas.v1.ScheduleCron
+	12, // 17: nitric.faas.v1.InitRequest.api:type_name -> nitric.faas.v1.ApiWorker
+	13, // 18: nitric.faas.v1.InitRequest.subscription:type_name -> nitric.faas.v1.SubscriptionWorker
+	15, // 19: nitric.faas.v1.InitRequest.schedule:type_name -> nitric.faas.v1.ScheduleWorker
+	14, // 20: nitric.faas.v1.InitRequest.websocket:type_name -> nitric.faas.v1.WebsocketWorker
+	34, // 21: nitric.faas.v1.TraceContext.values:type_name -> nitric.faas.v1.TraceContext.ValuesEntry
+	20, // 22: nitric.faas.v1.TriggerRequest.trace_context:type_name -> nitric.faas.v1.TraceContext
+	24, // 23: nitric.faas.v1.TriggerRequest.http:type_name -> nitric.faas.v1.HttpTriggerContext
+	25, // 24: nitric.faas.v1.TriggerRequest.topic:type_name -> nitric.faas.v1.TopicTriggerContext
+	26, // 25: nitric.faas.v1.TriggerRequest.websocket:type_name -> nitric.faas.v1.WebsocketTriggerContext
+	27, // 26: nitric.faas.v1.TriggerRequest.schedule:type_name -> nitric.faas.v1.ScheduleTriggerContext
+	35, // 27: nitric.faas.v1.HttpTriggerContext.headers_old:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersOldEntry
+	36, // 28: nitric.faas.v1.HttpTriggerContext.query_params_old:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsOldEntry
+	37, // 29: nitric.faas.v1.HttpTriggerContext.headers:type_name -> nitric.faas.v1.HttpTriggerContext.HeadersEntry
+	38, // 30: nitric.faas.v1.HttpTriggerContext.query_params:type_name -> nitric.faas.v1.HttpTriggerContext.QueryParamsEntry
+	39, // 31: nitric.faas.v1.HttpTriggerContext.path_params:type_name -> nitric.faas.v1.HttpTriggerContext.PathParamsEntry
+	44, // 32: nitric.faas.v1.HttpTriggerContext.claims:type_name -> google.protobuf.Struct
+	11, // 33: nitric.faas.v1.HttpTriggerContext.options:type_name -> nitric.faas.v1.ApiWorkerOptions
+	40, // 34: nitric.faas.v1.TopicTriggerContext.attributes:type_name -> nitric.faas.v1.TopicTriggerContext.AttributesEntry
+	45, // 35: nitric.faas.v1.TopicTriggerContext.publish_time:type_name -> google.protobuf.Timestamp
+	0,  // 36: nitric.faas.v1.WebsocketTriggerContext.event:type_name -> nitric.faas.v1.WebsocketEvent
+	41, // 37: nitric.faas.v1.WebsocketTriggerContext.query_params:type_name -> nitric.faas.v1.WebsocketTriggerContext.QueryParamsEntry
+	45, // 38: nitric.faas.v1.ScheduleTriggerContext.scheduled_time:type_name -> google.protobuf.Timestamp
+	45, // 39: nitric.faas.v1.ScheduleTriggerContext.fire_time:type_name -> google.protobuf.Timestamp
+	29, // 40: nitric.faas.v1.TriggerResponse.http:type_name -> nitric.faas.v1.HttpResponseContext
+	30, // 41: nitric.faas.v1.TriggerResponse.topic:type_name -> nitric.faas.v1.TopicResponseContext
+	31, // 42: nitric.faas.v1.TriggerResponse.websocket:type_name -> nitric.faas.v1.WebsocketResponseContext
+	32, // 43: nitric.faas.v1.TriggerResponse.schedule:type_name -> nitric.faas.v1.ScheduleResponseContext
+	42, // 44: nitric.faas.v1.HttpResponseContext.headers_old:type_name -> nitric.faas.v1.HttpResponseContext.HeadersOldEntry
+	43, // 45: nitric.faas.v1.HttpResponseContext.headers:type_name -> nitric.faas.v1.HttpResponseContext.HeadersEntry
+	10, // 46: nitric.faas.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.faas.v1.ApiWorkerScopes
+	22, // 47: nitric.faas.v1.HttpTriggerContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
+	23, // 48: nitric.faas.v1.HttpTriggerContext.QueryParamsEntry.value:type_name -> nitric.faas.v1.QueryValue
+	23, // 49: nitric.faas.v1.WebsocketTriggerContext.QueryParamsEntry.value:type_name -> nitric.faas.v1.QueryValue
+	22, // 50: nitric.faas.v1.HttpResponseContext.HeadersEntry.value:type_name -> nitric.faas.v1.HeaderValue
+	1,  // 51: nitric.faas.v1.FaasService.TriggerStream:input_type -> nitric.faas.v1.ClientMessage
+	2,  // 52: nitric.faas.v1.FaasService.TriggerStream:output_type -> nitric.faas.v1.ServerMessage
+	52, // [52:53] is the sub-list for method output_type
+	51, // [51:52] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_faas_v1_faas_proto_init() }
@@ -1733,7 +2949,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiWorkerScopes); i {
+			switch v := v.(*BodyChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1745,7 +2961,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiWorkerOptions); i {
+			switch v := v.(*CancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1757,7 +2973,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiWorker); i {
+			switch v := v.(*DrainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1769,7 +2985,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriptionWorker); i {
+			switch v := v.(*ShutdownRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1781,7 +2997,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleWorker); i {
+			switch v := v.(*ShutdownResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1793,7 +3009,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleRate); i {
+			switch v := v.(*Ping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1805,7 +3021,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduleCron); i {
+			switch v := v.(*Pong); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1817,7 +3033,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitRequest); i {
+			switch v := v.(*ApiWorkerScopes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1829,7 +3045,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitResponse); i {
+			switch v := v.(*ApiWorkerOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1841,7 +3057,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceContext); i {
+			switch v := v.(*ApiWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1853,7 +3069,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRequest); i {
+			switch v := v.(*SubscriptionWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1865,7 +3081,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeaderValue); i {
+			switch v := v.(*WebsocketWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1877,7 +3093,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValue); i {
+			switch v := v.(*ScheduleWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1889,7 +3105,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpTriggerContext); i {
+			switch v := v.(*ScheduleRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1901,7 +3117,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicTriggerContext); i {
+			switch v := v.(*ScheduleCron); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1913,7 +3129,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerResponse); i {
+			switch v := v.(*InitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1925,7 +3141,7 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpResponseContext); i {
+			switch v := v.(*InitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,6 +3153,126 @@ func file_faas_v1_faas_proto_init() {
 			}
 		}
 		file_faas_v1_faas_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpTriggerContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicTriggerContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebsocketTriggerContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleTriggerContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpResponseContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicResponseContext); i {
 			case 0:
 				return &v.state
@@ -1948,44 +3284,82 @@ func file_faas_v1_faas_proto_init() {
 				return nil
 			}
 		}
+		file_faas_v1_faas_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebsocketResponseContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_faas_v1_faas_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleResponseContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_faas_v1_faas_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ClientMessage_InitRequest)(nil),
 		(*ClientMessage_TriggerResponse)(nil),
+		(*ClientMessage_BodyChunk)(nil),
+		(*ClientMessage_ShutdownRequest)(nil),
+		(*ClientMessage_Pong)(nil),
 	}
 	file_faas_v1_faas_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*ServerMessage_InitResponse)(nil),
 		(*ServerMessage_TriggerRequest)(nil),
+		(*ServerMessage_CancelRequest)(nil),
+		(*ServerMessage_BodyChunk)(nil),
+		(*ServerMessage_DrainRequest)(nil),
+		(*ServerMessage_ShutdownResponse)(nil),
+		(*ServerMessage_Ping)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*ScheduleWorker_Rate)(nil),
 		(*ScheduleWorker_Cron)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*InitRequest_Api)(nil),
 		(*InitRequest_Subscription)(nil),
 		(*InitRequest_Schedule)(nil),
+		(*InitRequest_Websocket)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*TriggerRequest_Http)(nil),
 		(*TriggerRequest_Topic)(nil),
+		(*TriggerRequest_Websocket)(nil),
+		(*TriggerRequest_Schedule)(nil),
 	}
-	file_faas_v1_faas_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_faas_v1_faas_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*TriggerResponse_Http)(nil),
 		(*TriggerResponse_Topic)(nil),
+		(*TriggerResponse_Websocket)(nil),
+		(*TriggerResponse_Schedule)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_faas_v1_faas_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   29,
+			NumEnums:      1,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_faas_v1_faas_proto_goTypes,
 		DependencyIndexes: file_faas_v1_faas_proto_depIdxs,
+		EnumInfos:         file_faas_v1_faas_proto_enumTypes,
 		MessageInfos:      file_faas_v1_faas_proto_msgTypes,
 	}.Build()
 	File_faas_v1_faas_proto = out.File
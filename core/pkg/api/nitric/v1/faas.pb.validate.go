@@ -123,6 +123,99 @@ func (m *ClientMessage) validate(all bool) error {
 			}
 		}
 
+	case *ClientMessage_BodyChunk:
+
+		if all {
+			switch v := interface{}(m.GetBodyChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "BodyChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "BodyChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBodyChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "BodyChunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_ShutdownRequest:
+
+		if all {
+			switch v := interface{}(m.GetShutdownRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ShutdownRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ShutdownRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetShutdownRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "ShutdownRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_Pong:
+
+		if all {
+			switch v := interface{}(m.GetPong()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Pong",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Pong",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPong()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "Pong",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -291,6 +384,161 @@ func (m *ServerMessage) validate(all bool) error {
 			}
 		}
 
+	case *ServerMessage_CancelRequest:
+
+		if all {
+			switch v := interface{}(m.GetCancelRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "CancelRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "CancelRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCancelRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "CancelRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_BodyChunk:
+
+		if all {
+			switch v := interface{}(m.GetBodyChunk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "BodyChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "BodyChunk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBodyChunk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "BodyChunk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_DrainRequest:
+
+		if all {
+			switch v := interface{}(m.GetDrainRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "DrainRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "DrainRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDrainRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "DrainRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_ShutdownResponse:
+
+		if all {
+			switch v := interface{}(m.GetShutdownResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ShutdownResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ShutdownResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetShutdownResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "ShutdownResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Ping:
+
+		if all {
+			switch v := interface{}(m.GetPing()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPing()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Ping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -371,42 +619,45 @@ var _ interface {
 	ErrorName() string
 } = ServerMessageValidationError{}
 
-// Validate checks the field values on ApiWorkerScopes with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ApiWorkerScopes) Validate() error {
+// Validate checks the field values on BodyChunk with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BodyChunk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApiWorkerScopes with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ApiWorkerScopesMultiError, or nil if none found.
-func (m *ApiWorkerScopes) ValidateAll() error {
+// ValidateAll checks the field values on BodyChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BodyChunkMultiError, or nil
+// if none found.
+func (m *BodyChunk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApiWorkerScopes) validate(all bool) error {
+func (m *BodyChunk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Data
+
+	// no validation rules for End
+
 	if len(errors) > 0 {
-		return ApiWorkerScopesMultiError(errors)
+		return BodyChunkMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApiWorkerScopesMultiError is an error wrapping multiple validation errors
-// returned by ApiWorkerScopes.ValidateAll() if the designated constraints
-// aren't met.
-type ApiWorkerScopesMultiError []error
+// BodyChunkMultiError is an error wrapping multiple validation errors returned
+// by BodyChunk.ValidateAll() if the designated constraints aren't met.
+type BodyChunkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApiWorkerScopesMultiError) Error() string {
+func (m BodyChunkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -415,11 +666,11 @@ func (m ApiWorkerScopesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApiWorkerScopesMultiError) AllErrors() []error { return m }
+func (m BodyChunkMultiError) AllErrors() []error { return m }
 
-// ApiWorkerScopesValidationError is the validation error returned by
-// ApiWorkerScopes.Validate if the designated constraints aren't met.
-type ApiWorkerScopesValidationError struct {
+// BodyChunkValidationError is the validation error returned by
+// BodyChunk.Validate if the designated constraints aren't met.
+type BodyChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -427,22 +678,22 @@ type ApiWorkerScopesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApiWorkerScopesValidationError) Field() string { return e.field }
+func (e BodyChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApiWorkerScopesValidationError) Reason() string { return e.reason }
+func (e BodyChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApiWorkerScopesValidationError) Cause() error { return e.cause }
+func (e BodyChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApiWorkerScopesValidationError) Key() bool { return e.key }
+func (e BodyChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApiWorkerScopesValidationError) ErrorName() string { return "ApiWorkerScopesValidationError" }
+func (e BodyChunkValidationError) ErrorName() string { return "BodyChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApiWorkerScopesValidationError) Error() string {
+func (e BodyChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -454,14 +705,14 @@ func (e ApiWorkerScopesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApiWorkerScopes.%s: %s%s",
+		"invalid %sBodyChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApiWorkerScopesValidationError{}
+var _ error = BodyChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -469,92 +720,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApiWorkerScopesValidationError{}
+} = BodyChunkValidationError{}
 
-// Validate checks the field values on ApiWorkerOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ApiWorkerOptions) Validate() error {
+// Validate checks the field values on CancelRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CancelRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApiWorkerOptions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ApiWorkerOptionsMultiError, or nil if none found.
-func (m *ApiWorkerOptions) ValidateAll() error {
+// ValidateAll checks the field values on CancelRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CancelRequestMultiError, or
+// nil if none found.
+func (m *CancelRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApiWorkerOptions) validate(all bool) error {
+func (m *CancelRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetSecurity()))
-		i := 0
-		for key := range m.GetSecurity() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetSecurity()[key]
-			_ = val
-
-			// no validation rules for Security[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ApiWorkerOptionsValidationError{
-							field:  fmt.Sprintf("Security[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ApiWorkerOptionsValidationError{
-							field:  fmt.Sprintf("Security[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ApiWorkerOptionsValidationError{
-						field:  fmt.Sprintf("Security[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	// no validation rules for SecurityDisabled
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return ApiWorkerOptionsMultiError(errors)
+		return CancelRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApiWorkerOptionsMultiError is an error wrapping multiple validation errors
-// returned by ApiWorkerOptions.ValidateAll() if the designated constraints
+// CancelRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ApiWorkerOptionsMultiError []error
+type CancelRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApiWorkerOptionsMultiError) Error() string {
+func (m CancelRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -563,11 +768,11 @@ func (m ApiWorkerOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApiWorkerOptionsMultiError) AllErrors() []error { return m }
+func (m CancelRequestMultiError) AllErrors() []error { return m }
 
-// ApiWorkerOptionsValidationError is the validation error returned by
-// ApiWorkerOptions.Validate if the designated constraints aren't met.
-type ApiWorkerOptionsValidationError struct {
+// CancelRequestValidationError is the validation error returned by
+// CancelRequest.Validate if the designated constraints aren't met.
+type CancelRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -575,22 +780,22 @@ type ApiWorkerOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApiWorkerOptionsValidationError) Field() string { return e.field }
+func (e CancelRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApiWorkerOptionsValidationError) Reason() string { return e.reason }
+func (e CancelRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApiWorkerOptionsValidationError) Cause() error { return e.cause }
+func (e CancelRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApiWorkerOptionsValidationError) Key() bool { return e.key }
+func (e CancelRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApiWorkerOptionsValidationError) ErrorName() string { return "ApiWorkerOptionsValidationError" }
+func (e CancelRequestValidationError) ErrorName() string { return "CancelRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApiWorkerOptionsValidationError) Error() string {
+func (e CancelRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -602,14 +807,14 @@ func (e ApiWorkerOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApiWorkerOptions.%s: %s%s",
+		"invalid %sCancelRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApiWorkerOptionsValidationError{}
+var _ error = CancelRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -617,76 +822,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApiWorkerOptionsValidationError{}
+} = CancelRequestValidationError{}
 
-// Validate checks the field values on ApiWorker with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on DrainRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ApiWorker) Validate() error {
+func (m *DrainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApiWorker with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ApiWorkerMultiError, or nil
-// if none found.
-func (m *ApiWorker) ValidateAll() error {
+// ValidateAll checks the field values on DrainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DrainRequestMultiError, or
+// nil if none found.
+func (m *DrainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApiWorker) validate(all bool) error {
+func (m *DrainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Api
-
-	// no validation rules for Path
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ApiWorkerValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ApiWorkerValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ApiWorkerValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return ApiWorkerMultiError(errors)
+		return DrainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApiWorkerMultiError is an error wrapping multiple validation errors returned
-// by ApiWorker.ValidateAll() if the designated constraints aren't met.
-type ApiWorkerMultiError []error
+// DrainRequestMultiError is an error wrapping multiple validation errors
+// returned by DrainRequest.ValidateAll() if the designated constraints aren't met.
+type DrainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApiWorkerMultiError) Error() string {
+func (m DrainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -695,11 +869,11 @@ func (m ApiWorkerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApiWorkerMultiError) AllErrors() []error { return m }
+func (m DrainRequestMultiError) AllErrors() []error { return m }
 
-// ApiWorkerValidationError is the validation error returned by
-// ApiWorker.Validate if the designated constraints aren't met.
-type ApiWorkerValidationError struct {
+// DrainRequestValidationError is the validation error returned by
+// DrainRequest.Validate if the designated constraints aren't met.
+type DrainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -707,22 +881,22 @@ type ApiWorkerValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApiWorkerValidationError) Field() string { return e.field }
+func (e DrainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApiWorkerValidationError) Reason() string { return e.reason }
+func (e DrainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApiWorkerValidationError) Cause() error { return e.cause }
+func (e DrainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApiWorkerValidationError) Key() bool { return e.key }
+func (e DrainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApiWorkerValidationError) ErrorName() string { return "ApiWorkerValidationError" }
+func (e DrainRequestValidationError) ErrorName() string { return "DrainRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApiWorkerValidationError) Error() string {
+func (e DrainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -734,14 +908,14 @@ func (e ApiWorkerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApiWorker.%s: %s%s",
+		"invalid %sDrainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApiWorkerValidationError{}
+var _ error = DrainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -749,46 +923,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApiWorkerValidationError{}
+} = DrainRequestValidationError{}
 
-// Validate checks the field values on SubscriptionWorker with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubscriptionWorker) Validate() error {
+// Validate checks the field values on ShutdownRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShutdownRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubscriptionWorker with the rules
+// ValidateAll checks the field values on ShutdownRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SubscriptionWorkerMultiError, or nil if none found.
-func (m *SubscriptionWorker) ValidateAll() error {
+// ShutdownRequestMultiError, or nil if none found.
+func (m *ShutdownRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubscriptionWorker) validate(all bool) error {
+func (m *ShutdownRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Topic
-
 	if len(errors) > 0 {
-		return SubscriptionWorkerMultiError(errors)
+		return ShutdownRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubscriptionWorkerMultiError is an error wrapping multiple validation errors
-// returned by SubscriptionWorker.ValidateAll() if the designated constraints
+// ShutdownRequestMultiError is an error wrapping multiple validation errors
+// returned by ShutdownRequest.ValidateAll() if the designated constraints
 // aren't met.
-type SubscriptionWorkerMultiError []error
+type ShutdownRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubscriptionWorkerMultiError) Error() string {
+func (m ShutdownRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -797,11 +969,11 @@ func (m SubscriptionWorkerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubscriptionWorkerMultiError) AllErrors() []error { return m }
+func (m ShutdownRequestMultiError) AllErrors() []error { return m }
 
-// SubscriptionWorkerValidationError is the validation error returned by
-// SubscriptionWorker.Validate if the designated constraints aren't met.
-type SubscriptionWorkerValidationError struct {
+// ShutdownRequestValidationError is the validation error returned by
+// ShutdownRequest.Validate if the designated constraints aren't met.
+type ShutdownRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -809,24 +981,22 @@ type SubscriptionWorkerValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubscriptionWorkerValidationError) Field() string { return e.field }
+func (e ShutdownRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubscriptionWorkerValidationError) Reason() string { return e.reason }
+func (e ShutdownRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubscriptionWorkerValidationError) Cause() error { return e.cause }
+func (e ShutdownRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubscriptionWorkerValidationError) Key() bool { return e.key }
+func (e ShutdownRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubscriptionWorkerValidationError) ErrorName() string {
-	return "SubscriptionWorkerValidationError"
-}
+func (e ShutdownRequestValidationError) ErrorName() string { return "ShutdownRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SubscriptionWorkerValidationError) Error() string {
+func (e ShutdownRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -838,14 +1008,14 @@ func (e SubscriptionWorkerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubscriptionWorker.%s: %s%s",
+		"invalid %sShutdownRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubscriptionWorkerValidationError{}
+var _ error = ShutdownRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -853,112 +1023,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubscriptionWorkerValidationError{}
+} = ShutdownRequestValidationError{}
 
-// Validate checks the field values on ScheduleWorker with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ScheduleWorker) Validate() error {
+// Validate checks the field values on ShutdownResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShutdownResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScheduleWorker with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleWorkerMultiError,
-// or nil if none found.
-func (m *ScheduleWorker) ValidateAll() error {
+// ValidateAll checks the field values on ShutdownResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShutdownResponseMultiError, or nil if none found.
+func (m *ShutdownResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScheduleWorker) validate(all bool) error {
+func (m *ShutdownResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
-	switch m.Cadence.(type) {
-
-	case *ScheduleWorker_Rate:
-
-		if all {
-			switch v := interface{}(m.GetRate()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ScheduleWorkerValidationError{
-						field:  "Rate",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ScheduleWorkerValidationError{
-						field:  "Rate",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRate()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ScheduleWorkerValidationError{
-					field:  "Rate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ScheduleWorker_Cron:
-
-		if all {
-			switch v := interface{}(m.GetCron()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ScheduleWorkerValidationError{
-						field:  "Cron",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ScheduleWorkerValidationError{
-						field:  "Cron",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCron()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ScheduleWorkerValidationError{
-					field:  "Cron",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ScheduleWorkerMultiError(errors)
+		return ShutdownResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScheduleWorkerMultiError is an error wrapping multiple validation errors
-// returned by ScheduleWorker.ValidateAll() if the designated constraints
+// ShutdownResponseMultiError is an error wrapping multiple validation errors
+// returned by ShutdownResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ScheduleWorkerMultiError []error
+type ShutdownResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleWorkerMultiError) Error() string {
+func (m ShutdownResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -967,11 +1069,11 @@ func (m ScheduleWorkerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleWorkerMultiError) AllErrors() []error { return m }
+func (m ShutdownResponseMultiError) AllErrors() []error { return m }
 
-// ScheduleWorkerValidationError is the validation error returned by
-// ScheduleWorker.Validate if the designated constraints aren't met.
-type ScheduleWorkerValidationError struct {
+// ShutdownResponseValidationError is the validation error returned by
+// ShutdownResponse.Validate if the designated constraints aren't met.
+type ShutdownResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -979,22 +1081,22 @@ type ScheduleWorkerValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleWorkerValidationError) Field() string { return e.field }
+func (e ShutdownResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleWorkerValidationError) Reason() string { return e.reason }
+func (e ShutdownResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleWorkerValidationError) Cause() error { return e.cause }
+func (e ShutdownResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleWorkerValidationError) Key() bool { return e.key }
+func (e ShutdownResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleWorkerValidationError) ErrorName() string { return "ScheduleWorkerValidationError" }
+func (e ShutdownResponseValidationError) ErrorName() string { return "ShutdownResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ScheduleWorkerValidationError) Error() string {
+func (e ShutdownResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1006,14 +1108,14 @@ func (e ScheduleWorkerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScheduleWorker.%s: %s%s",
+		"invalid %sShutdownResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleWorkerValidationError{}
+var _ error = ShutdownResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1021,45 +1123,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleWorkerValidationError{}
+} = ShutdownResponseValidationError{}
 
-// Validate checks the field values on ScheduleRate with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ScheduleRate) Validate() error {
+// Validate checks the field values on Ping with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Ping) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScheduleRate with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleRateMultiError, or
-// nil if none found.
-func (m *ScheduleRate) ValidateAll() error {
+// ValidateAll checks the field values on Ping with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PingMultiError, or nil if none found.
+func (m *Ping) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScheduleRate) validate(all bool) error {
+func (m *Ping) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Rate
-
 	if len(errors) > 0 {
-		return ScheduleRateMultiError(errors)
+		return PingMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScheduleRateMultiError is an error wrapping multiple validation errors
-// returned by ScheduleRate.ValidateAll() if the designated constraints aren't met.
-type ScheduleRateMultiError []error
+// PingMultiError is an error wrapping multiple validation errors returned by
+// Ping.ValidateAll() if the designated constraints aren't met.
+type PingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleRateMultiError) Error() string {
+func (m PingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1068,11 +1167,11 @@ func (m ScheduleRateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleRateMultiError) AllErrors() []error { return m }
+func (m PingMultiError) AllErrors() []error { return m }
 
-// ScheduleRateValidationError is the validation error returned by
-// ScheduleRate.Validate if the designated constraints aren't met.
-type ScheduleRateValidationError struct {
+// PingValidationError is the validation error returned by Ping.Validate if the
+// designated constraints aren't met.
+type PingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1080,22 +1179,22 @@ type ScheduleRateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleRateValidationError) Field() string { return e.field }
+func (e PingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleRateValidationError) Reason() string { return e.reason }
+func (e PingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleRateValidationError) Cause() error { return e.cause }
+func (e PingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleRateValidationError) Key() bool { return e.key }
+func (e PingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleRateValidationError) ErrorName() string { return "ScheduleRateValidationError" }
+func (e PingValidationError) ErrorName() string { return "PingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ScheduleRateValidationError) Error() string {
+func (e PingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1107,14 +1206,14 @@ func (e ScheduleRateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScheduleRate.%s: %s%s",
+		"invalid %sPing.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleRateValidationError{}
+var _ error = PingValidationError{}
 
 var _ interface {
 	Field() string
@@ -1122,45 +1221,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleRateValidationError{}
+} = PingValidationError{}
 
-// Validate checks the field values on ScheduleCron with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ScheduleCron) Validate() error {
+// Validate checks the field values on Pong with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Pong) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ScheduleCron with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ScheduleCronMultiError, or
-// nil if none found.
-func (m *ScheduleCron) ValidateAll() error {
+// ValidateAll checks the field values on Pong with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PongMultiError, or nil if none found.
+func (m *Pong) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ScheduleCron) validate(all bool) error {
+func (m *Pong) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cron
-
 	if len(errors) > 0 {
-		return ScheduleCronMultiError(errors)
+		return PongMultiError(errors)
 	}
 
 	return nil
 }
 
-// ScheduleCronMultiError is an error wrapping multiple validation errors
-// returned by ScheduleCron.ValidateAll() if the designated constraints aren't met.
-type ScheduleCronMultiError []error
+// PongMultiError is an error wrapping multiple validation errors returned by
+// Pong.ValidateAll() if the designated constraints aren't met.
+type PongMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ScheduleCronMultiError) Error() string {
+func (m PongMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1169,11 +1265,11 @@ func (m ScheduleCronMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ScheduleCronMultiError) AllErrors() []error { return m }
+func (m PongMultiError) AllErrors() []error { return m }
 
-// ScheduleCronValidationError is the validation error returned by
-// ScheduleCron.Validate if the designated constraints aren't met.
-type ScheduleCronValidationError struct {
+// PongValidationError is the validation error returned by Pong.Validate if the
+// designated constraints aren't met.
+type PongValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1181,22 +1277,22 @@ type ScheduleCronValidationError struct {
 }
 
 // Field function returns field value.
-func (e ScheduleCronValidationError) Field() string { return e.field }
+func (e PongValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ScheduleCronValidationError) Reason() string { return e.reason }
+func (e PongValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ScheduleCronValidationError) Cause() error { return e.cause }
+func (e PongValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ScheduleCronValidationError) Key() bool { return e.key }
+func (e PongValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ScheduleCronValidationError) ErrorName() string { return "ScheduleCronValidationError" }
+func (e PongValidationError) ErrorName() string { return "PongValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ScheduleCronValidationError) Error() string {
+func (e PongValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1208,14 +1304,14 @@ func (e ScheduleCronValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sScheduleCron.%s: %s%s",
+		"invalid %sPong.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ScheduleCronValidationError{}
+var _ error = PongValidationError{}
 
 var _ interface {
 	Field() string
@@ -1223,140 +1319,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ScheduleCronValidationError{}
+} = PongValidationError{}
 
-// Validate checks the field values on InitRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InitRequest) Validate() error {
+// Validate checks the field values on ApiWorkerScopes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApiWorkerScopes) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InitRequestMultiError, or
-// nil if none found.
-func (m *InitRequest) ValidateAll() error {
+// ValidateAll checks the field values on ApiWorkerScopes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiWorkerScopesMultiError, or nil if none found.
+func (m *ApiWorkerScopes) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitRequest) validate(all bool) error {
+func (m *ApiWorkerScopes) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch m.Worker.(type) {
-
-	case *InitRequest_Api:
-
-		if all {
-			switch v := interface{}(m.GetApi()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Api",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Api",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetApi()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Api",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *InitRequest_Subscription:
-
-		if all {
-			switch v := interface{}(m.GetSubscription()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Subscription",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Subscription",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Subscription",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *InitRequest_Schedule:
-
-		if all {
-			switch v := interface{}(m.GetSchedule()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Schedule",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InitRequestValidationError{
-						field:  "Schedule",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InitRequestValidationError{
-					field:  "Schedule",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return InitRequestMultiError(errors)
+		return ApiWorkerScopesMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitRequestMultiError is an error wrapping multiple validation errors
-// returned by InitRequest.ValidateAll() if the designated constraints aren't met.
-type InitRequestMultiError []error
+// ApiWorkerScopesMultiError is an error wrapping multiple validation errors
+// returned by ApiWorkerScopes.ValidateAll() if the designated constraints
+// aren't met.
+type ApiWorkerScopesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitRequestMultiError) Error() string {
+func (m ApiWorkerScopesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1365,11 +1365,11 @@ func (m InitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitRequestMultiError) AllErrors() []error { return m }
+func (m ApiWorkerScopesMultiError) AllErrors() []error { return m }
 
-// InitRequestValidationError is the validation error returned by
-// InitRequest.Validate if the designated constraints aren't met.
-type InitRequestValidationError struct {
+// ApiWorkerScopesValidationError is the validation error returned by
+// ApiWorkerScopes.Validate if the designated constraints aren't met.
+type ApiWorkerScopesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1377,22 +1377,22 @@ type InitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitRequestValidationError) Field() string { return e.field }
+func (e ApiWorkerScopesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitRequestValidationError) Reason() string { return e.reason }
+func (e ApiWorkerScopesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitRequestValidationError) Cause() error { return e.cause }
+func (e ApiWorkerScopesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitRequestValidationError) Key() bool { return e.key }
+func (e ApiWorkerScopesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitRequestValidationError) ErrorName() string { return "InitRequestValidationError" }
+func (e ApiWorkerScopesValidationError) ErrorName() string { return "ApiWorkerScopesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitRequestValidationError) Error() string {
+func (e ApiWorkerScopesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1404,14 +1404,14 @@ func (e InitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitRequest.%s: %s%s",
+		"invalid %sApiWorkerScopes.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitRequestValidationError{}
+var _ error = ApiWorkerScopesValidationError{}
 
 var _ interface {
 	Field() string
@@ -1419,56 +1419,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitRequestValidationError{}
+} = ApiWorkerScopesValidationError{}
 
-// Validate checks the field values on InitResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InitResponse) Validate() error {
+// Validate checks the field values on ApiWorkerOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApiWorkerOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in InitResponseMultiError, or
-// nil if none found.
-func (m *InitResponse) ValidateAll() error {
+// ValidateAll checks the field values on ApiWorkerOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiWorkerOptionsMultiError, or nil if none found.
+func (m *ApiWorkerOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitResponse) validate(all bool) error {
+func (m *ApiWorkerOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return InitResponseMultiError(errors)
-	}
-
-	return nil
-}
+	{
+		sorted_keys := make([]string, len(m.GetSecurity()))
+		i := 0
+		for key := range m.GetSecurity() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetSecurity()[key]
+			_ = val
 
-// InitResponseMultiError is an error wrapping multiple validation errors
-// returned by InitResponse.ValidateAll() if the designated constraints aren't met.
-type InitResponseMultiError []error
+			// no validation rules for Security[key]
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m InitResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ApiWorkerOptionsValidationError{
+							field:  fmt.Sprintf("Security[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ApiWorkerOptionsValidationError{
+							field:  fmt.Sprintf("Security[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ApiWorkerOptionsValidationError{
+						field:  fmt.Sprintf("Security[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for SecurityDisabled
+
+	if len(errors) > 0 {
+		return ApiWorkerOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiWorkerOptionsMultiError is an error wrapping multiple validation errors
+// returned by ApiWorkerOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ApiWorkerOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiWorkerOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitResponseMultiError) AllErrors() []error { return m }
+func (m ApiWorkerOptionsMultiError) AllErrors() []error { return m }
 
-// InitResponseValidationError is the validation error returned by
-// InitResponse.Validate if the designated constraints aren't met.
-type InitResponseValidationError struct {
+// ApiWorkerOptionsValidationError is the validation error returned by
+// ApiWorkerOptions.Validate if the designated constraints aren't met.
+type ApiWorkerOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1476,22 +1525,22 @@ type InitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitResponseValidationError) Field() string { return e.field }
+func (e ApiWorkerOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitResponseValidationError) Reason() string { return e.reason }
+func (e ApiWorkerOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitResponseValidationError) Cause() error { return e.cause }
+func (e ApiWorkerOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitResponseValidationError) Key() bool { return e.key }
+func (e ApiWorkerOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitResponseValidationError) ErrorName() string { return "InitResponseValidationError" }
+func (e ApiWorkerOptionsValidationError) ErrorName() string { return "ApiWorkerOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitResponseValidationError) Error() string {
+func (e ApiWorkerOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1503,14 +1552,14 @@ func (e InitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitResponse.%s: %s%s",
+		"invalid %sApiWorkerOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitResponseValidationError{}
+var _ error = ApiWorkerOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1518,45 +1567,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitResponseValidationError{}
+} = ApiWorkerOptionsValidationError{}
 
-// Validate checks the field values on TraceContext with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ApiWorker with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TraceContext) Validate() error {
+func (m *ApiWorker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TraceContext with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TraceContextMultiError, or
-// nil if none found.
-func (m *TraceContext) ValidateAll() error {
+// ValidateAll checks the field values on ApiWorker with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ApiWorkerMultiError, or nil
+// if none found.
+func (m *ApiWorker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TraceContext) validate(all bool) error {
+func (m *ApiWorker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Values
+	// no validation rules for Api
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiWorkerValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiWorkerValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiWorkerValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TraceContextMultiError(errors)
+		return ApiWorkerMultiError(errors)
 	}
 
 	return nil
 }
 
-// TraceContextMultiError is an error wrapping multiple validation errors
-// returned by TraceContext.ValidateAll() if the designated constraints aren't met.
-type TraceContextMultiError []error
+// ApiWorkerMultiError is an error wrapping multiple validation errors returned
+// by ApiWorker.ValidateAll() if the designated constraints aren't met.
+type ApiWorkerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TraceContextMultiError) Error() string {
+func (m ApiWorkerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1565,11 +1645,11 @@ func (m TraceContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TraceContextMultiError) AllErrors() []error { return m }
+func (m ApiWorkerMultiError) AllErrors() []error { return m }
 
-// TraceContextValidationError is the validation error returned by
-// TraceContext.Validate if the designated constraints aren't met.
-type TraceContextValidationError struct {
+// ApiWorkerValidationError is the validation error returned by
+// ApiWorker.Validate if the designated constraints aren't met.
+type ApiWorkerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1577,22 +1657,22 @@ type TraceContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e TraceContextValidationError) Field() string { return e.field }
+func (e ApiWorkerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TraceContextValidationError) Reason() string { return e.reason }
+func (e ApiWorkerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TraceContextValidationError) Cause() error { return e.cause }
+func (e ApiWorkerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TraceContextValidationError) Key() bool { return e.key }
+func (e ApiWorkerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TraceContextValidationError) ErrorName() string { return "TraceContextValidationError" }
+func (e ApiWorkerValidationError) ErrorName() string { return "ApiWorkerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TraceContextValidationError) Error() string {
+func (e ApiWorkerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1604,14 +1684,14 @@ func (e TraceContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTraceContext.%s: %s%s",
+		"invalid %sApiWorker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TraceContextValidationError{}
+var _ error = ApiWorkerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1619,121 +1699,298 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TraceContextValidationError{}
+} = ApiWorkerValidationError{}
 
-// Validate checks the field values on TriggerRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TriggerRequest) Validate() error {
+// Validate checks the field values on SubscriptionWorker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscriptionWorker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TriggerRequestMultiError,
-// or nil if none found.
-func (m *TriggerRequest) ValidateAll() error {
+// ValidateAll checks the field values on SubscriptionWorker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscriptionWorkerMultiError, or nil if none found.
+func (m *SubscriptionWorker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRequest) validate(all bool) error {
+func (m *SubscriptionWorker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Topic
 
-	// no validation rules for MimeType
+	if len(errors) > 0 {
+		return SubscriptionWorkerMultiError(errors)
+	}
 
-	if all {
-		switch v := interface{}(m.GetTraceContext()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TriggerRequestValidationError{
-					field:  "TraceContext",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TriggerRequestValidationError{
-					field:  "TraceContext",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTraceContext()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TriggerRequestValidationError{
-				field:  "TraceContext",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// SubscriptionWorkerMultiError is an error wrapping multiple validation errors
+// returned by SubscriptionWorker.ValidateAll() if the designated constraints
+// aren't met.
+type SubscriptionWorkerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionWorkerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	switch m.Context.(type) {
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionWorkerMultiError) AllErrors() []error { return m }
 
-	case *TriggerRequest_Http:
+// SubscriptionWorkerValidationError is the validation error returned by
+// SubscriptionWorker.Validate if the designated constraints aren't met.
+type SubscriptionWorkerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if all {
-			switch v := interface{}(m.GetHttp()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Http",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Http",
+// Field function returns field value.
+func (e SubscriptionWorkerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionWorkerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionWorkerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionWorkerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionWorkerValidationError) ErrorName() string {
+	return "SubscriptionWorkerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscriptionWorkerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscriptionWorker.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionWorkerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionWorkerValidationError{}
+
+// Validate checks the field values on WebsocketWorker with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketWorker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketWorker with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketWorkerMultiError, or nil if none found.
+func (m *WebsocketWorker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketWorker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Socket
+
+	// no validation rules for Event
+
+	if len(errors) > 0 {
+		return WebsocketWorkerMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketWorkerMultiError is an error wrapping multiple validation errors
+// returned by WebsocketWorker.ValidateAll() if the designated constraints
+// aren't met.
+type WebsocketWorkerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketWorkerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketWorkerMultiError) AllErrors() []error { return m }
+
+// WebsocketWorkerValidationError is the validation error returned by
+// WebsocketWorker.Validate if the designated constraints aren't met.
+type WebsocketWorkerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketWorkerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketWorkerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketWorkerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketWorkerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketWorkerValidationError) ErrorName() string { return "WebsocketWorkerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebsocketWorkerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketWorker.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketWorkerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketWorkerValidationError{}
+
+// Validate checks the field values on ScheduleWorker with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScheduleWorker) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleWorker with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleWorkerMultiError,
+// or nil if none found.
+func (m *ScheduleWorker) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleWorker) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	switch m.Cadence.(type) {
+
+	case *ScheduleWorker_Rate:
+
+		if all {
+			switch v := interface{}(m.GetRate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScheduleWorkerValidationError{
+						field:  "Rate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScheduleWorkerValidationError{
+						field:  "Rate",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRate()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TriggerRequestValidationError{
-					field:  "Http",
+				return ScheduleWorkerValidationError{
+					field:  "Rate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *TriggerRequest_Topic:
+	case *ScheduleWorker_Cron:
 
 		if all {
-			switch v := interface{}(m.GetTopic()).(type) {
+			switch v := interface{}(m.GetCron()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Topic",
+					errors = append(errors, ScheduleWorkerValidationError{
+						field:  "Cron",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TriggerRequestValidationError{
-						field:  "Topic",
+					errors = append(errors, ScheduleWorkerValidationError{
+						field:  "Cron",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCron()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TriggerRequestValidationError{
-					field:  "Topic",
+				return ScheduleWorkerValidationError{
+					field:  "Cron",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1743,19 +2000,19 @@ func (m *TriggerRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TriggerRequestMultiError(errors)
+		return ScheduleWorkerMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRequestMultiError is an error wrapping multiple validation errors
-// returned by TriggerRequest.ValidateAll() if the designated constraints
+// ScheduleWorkerMultiError is an error wrapping multiple validation errors
+// returned by ScheduleWorker.ValidateAll() if the designated constraints
 // aren't met.
-type TriggerRequestMultiError []error
+type ScheduleWorkerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRequestMultiError) Error() string {
+func (m ScheduleWorkerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1764,11 +2021,11 @@ func (m TriggerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRequestMultiError) AllErrors() []error { return m }
+func (m ScheduleWorkerMultiError) AllErrors() []error { return m }
 
-// TriggerRequestValidationError is the validation error returned by
-// TriggerRequest.Validate if the designated constraints aren't met.
-type TriggerRequestValidationError struct {
+// ScheduleWorkerValidationError is the validation error returned by
+// ScheduleWorker.Validate if the designated constraints aren't met.
+type ScheduleWorkerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1776,22 +2033,22 @@ type TriggerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRequestValidationError) Field() string { return e.field }
+func (e ScheduleWorkerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRequestValidationError) Reason() string { return e.reason }
+func (e ScheduleWorkerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRequestValidationError) Cause() error { return e.cause }
+func (e ScheduleWorkerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRequestValidationError) Key() bool { return e.key }
+func (e ScheduleWorkerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRequestValidationError) ErrorName() string { return "TriggerRequestValidationError" }
+func (e ScheduleWorkerValidationError) ErrorName() string { return "ScheduleWorkerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerRequestValidationError) Error() string {
+func (e ScheduleWorkerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1803,14 +2060,14 @@ func (e TriggerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRequest.%s: %s%s",
+		"invalid %sScheduleWorker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRequestValidationError{}
+var _ error = ScheduleWorkerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1818,43 +2075,1304 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRequestValidationError{}
+} = ScheduleWorkerValidationError{}
 
-// Validate checks the field values on HeaderValue with the rules defined in
+// Validate checks the field values on ScheduleRate with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *HeaderValue) Validate() error {
+func (m *ScheduleRate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HeaderValue with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in HeaderValueMultiError, or
+// ValidateAll checks the field values on ScheduleRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleRateMultiError, or
 // nil if none found.
-func (m *HeaderValue) ValidateAll() error {
+func (m *ScheduleRate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HeaderValue) validate(all bool) error {
+func (m *ScheduleRate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Rate
+
+	if len(errors) > 0 {
+		return ScheduleRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleRateMultiError is an error wrapping multiple validation errors
+// returned by ScheduleRate.ValidateAll() if the designated constraints aren't met.
+type ScheduleRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleRateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleRateMultiError) AllErrors() []error { return m }
+
+// ScheduleRateValidationError is the validation error returned by
+// ScheduleRate.Validate if the designated constraints aren't met.
+type ScheduleRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleRateValidationError) ErrorName() string { return "ScheduleRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduleRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleRateValidationError{}
+
+// Validate checks the field values on ScheduleCron with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScheduleCron) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleCron with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleCronMultiError, or
+// nil if none found.
+func (m *ScheduleCron) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleCron) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cron
+
+	if len(errors) > 0 {
+		return ScheduleCronMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleCronMultiError is an error wrapping multiple validation errors
+// returned by ScheduleCron.ValidateAll() if the designated constraints aren't met.
+type ScheduleCronMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleCronMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleCronMultiError) AllErrors() []error { return m }
+
+// ScheduleCronValidationError is the validation error returned by
+// ScheduleCron.Validate if the designated constraints aren't met.
+type ScheduleCronValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleCronValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleCronValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleCronValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleCronValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleCronValidationError) ErrorName() string { return "ScheduleCronValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduleCronValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleCron.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleCronValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleCronValidationError{}
+
+// Validate checks the field values on InitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InitRequestMultiError, or
+// nil if none found.
+func (m *InitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BodyStreaming
+
+	// no validation rules for MaxConcurrency
+
+	// no validation rules for Heartbeat
+
+	// no validation rules for ScheduleTriggers
+
+	switch m.Worker.(type) {
+
+	case *InitRequest_Api:
+
+		if all {
+			switch v := interface{}(m.GetApi()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Api",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Api",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApi()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Api",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InitRequest_Subscription:
+
+		if all {
+			switch v := interface{}(m.GetSubscription()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Subscription",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Subscription",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InitRequest_Schedule:
+
+		if all {
+			switch v := interface{}(m.GetSchedule()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InitRequest_Websocket:
+
+		if all {
+			switch v := interface{}(m.GetWebsocket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitRequestValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebsocket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitRequestValidationError{
+					field:  "Websocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitRequestMultiError is an error wrapping multiple validation errors
+// returned by InitRequest.ValidateAll() if the designated constraints aren't met.
+type InitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitRequestMultiError) AllErrors() []error { return m }
+
+// InitRequestValidationError is the validation error returned by
+// InitRequest.Validate if the designated constraints aren't met.
+type InitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitRequestValidationError) ErrorName() string { return "InitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitRequestValidationError{}
+
+// Validate checks the field values on InitResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InitResponseMultiError, or
+// nil if none found.
+func (m *InitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitResponseMultiError is an error wrapping multiple validation errors
+// returned by InitResponse.ValidateAll() if the designated constraints aren't met.
+type InitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitResponseMultiError) AllErrors() []error { return m }
+
+// InitResponseValidationError is the validation error returned by
+// InitResponse.Validate if the designated constraints aren't met.
+type InitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitResponseValidationError) ErrorName() string { return "InitResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitResponseValidationError{}
+
+// Validate checks the field values on TraceContext with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TraceContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TraceContext with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TraceContextMultiError, or
+// nil if none found.
+func (m *TraceContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TraceContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Values
+
+	if len(errors) > 0 {
+		return TraceContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// TraceContextMultiError is an error wrapping multiple validation errors
+// returned by TraceContext.ValidateAll() if the designated constraints aren't met.
+type TraceContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TraceContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TraceContextMultiError) AllErrors() []error { return m }
+
+// TraceContextValidationError is the validation error returned by
+// TraceContext.Validate if the designated constraints aren't met.
+type TraceContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TraceContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TraceContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TraceContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TraceContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TraceContextValidationError) ErrorName() string { return "TraceContextValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TraceContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTraceContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TraceContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TraceContextValidationError{}
+
+// Validate checks the field values on TriggerRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TriggerRequestMultiError,
+// or nil if none found.
+func (m *TriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for MimeType
+
+	if all {
+		switch v := interface{}(m.GetTraceContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTraceContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRequestValidationError{
+				field:  "TraceContext",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch m.Context.(type) {
+
+	case *TriggerRequest_Http:
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerRequest_Topic:
+
+		if all {
+			switch v := interface{}(m.GetTopic()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Topic",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTopic()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Topic",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerRequest_Websocket:
+
+		if all {
+			switch v := interface{}(m.GetWebsocket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebsocket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Websocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerRequest_Schedule:
+
+		if all {
+			switch v := interface{}(m.GetSchedule()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerRequestValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerRequestValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerRequestMultiError) AllErrors() []error { return m }
+
+// TriggerRequestValidationError is the validation error returned by
+// TriggerRequest.Validate if the designated constraints aren't met.
+type TriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerRequestValidationError) ErrorName() string { return "TriggerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerRequestValidationError{}
+
+// Validate checks the field values on HeaderValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeaderValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderValueMultiError, or
+// nil if none found.
+func (m *HeaderValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeaderValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return HeaderValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeaderValueMultiError is an error wrapping multiple validation errors
+// returned by HeaderValue.ValidateAll() if the designated constraints aren't met.
+type HeaderValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeaderValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeaderValueMultiError) AllErrors() []error { return m }
+
+// HeaderValueValidationError is the validation error returned by
+// HeaderValue.Validate if the designated constraints aren't met.
+type HeaderValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeaderValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeaderValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeaderValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeaderValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeaderValueValidationError) ErrorName() string { return "HeaderValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeaderValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaderValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeaderValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeaderValueValidationError{}
+
+// Validate checks the field values on QueryValue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryValueMultiError, or
+// nil if none found.
+func (m *QueryValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return QueryValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryValueMultiError is an error wrapping multiple validation errors
+// returned by QueryValue.ValidateAll() if the designated constraints aren't met.
+type QueryValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryValueMultiError) AllErrors() []error { return m }
+
+// QueryValueValidationError is the validation error returned by
+// QueryValue.Validate if the designated constraints aren't met.
+type QueryValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryValueValidationError) ErrorName() string { return "QueryValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryValueValidationError{}
+
+// Validate checks the field values on HttpTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HttpTriggerContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpTriggerContextMultiError, or nil if none found.
+func (m *HttpTriggerContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpTriggerContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Path
+
+	// no validation rules for HeadersOld
+
+	// no validation rules for QueryParamsOld
+
+	{
+		sorted_keys := make([]string, len(m.GetHeaders()))
+		i := 0
+		for key := range m.GetHeaders() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHeaders()[key]
+			_ = val
+
+			// no validation rules for Headers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("Headers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpTriggerContextValidationError{
+						field:  fmt.Sprintf("Headers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetQueryParams()))
+		i := 0
+		for key := range m.GetQueryParams() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetQueryParams()[key]
+			_ = val
+
+			// no validation rules for QueryParams[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("QueryParams[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, HttpTriggerContextValidationError{
+							field:  fmt.Sprintf("QueryParams[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return HttpTriggerContextValidationError{
+						field:  fmt.Sprintf("QueryParams[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for PathParams
+
+	if all {
+		switch v := interface{}(m.GetClaims()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Claims",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Claims",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaims()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpTriggerContextValidationError{
+				field:  "Claims",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Api
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HttpTriggerContextValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HttpTriggerContextValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StreamedBody
 
 	if len(errors) > 0 {
-		return HeaderValueMultiError(errors)
+		return HttpTriggerContextMultiError(errors)
 	}
 
 	return nil
 }
 
-// HeaderValueMultiError is an error wrapping multiple validation errors
-// returned by HeaderValue.ValidateAll() if the designated constraints aren't met.
-type HeaderValueMultiError []error
+// HttpTriggerContextMultiError is an error wrapping multiple validation errors
+// returned by HttpTriggerContext.ValidateAll() if the designated constraints
+// aren't met.
+type HttpTriggerContextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HeaderValueMultiError) Error() string {
+func (m HttpTriggerContextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1863,11 +3381,11 @@ func (m HeaderValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HeaderValueMultiError) AllErrors() []error { return m }
+func (m HttpTriggerContextMultiError) AllErrors() []error { return m }
 
-// HeaderValueValidationError is the validation error returned by
-// HeaderValue.Validate if the designated constraints aren't met.
-type HeaderValueValidationError struct {
+// HttpTriggerContextValidationError is the validation error returned by
+// HttpTriggerContext.Validate if the designated constraints aren't met.
+type HttpTriggerContextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1875,22 +3393,24 @@ type HeaderValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e HeaderValueValidationError) Field() string { return e.field }
+func (e HttpTriggerContextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HeaderValueValidationError) Reason() string { return e.reason }
+func (e HttpTriggerContextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HeaderValueValidationError) Cause() error { return e.cause }
+func (e HttpTriggerContextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HeaderValueValidationError) Key() bool { return e.key }
+func (e HttpTriggerContextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HeaderValueValidationError) ErrorName() string { return "HeaderValueValidationError" }
+func (e HttpTriggerContextValidationError) ErrorName() string {
+	return "HttpTriggerContextValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e HeaderValueValidationError) Error() string {
+func (e HttpTriggerContextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1902,14 +3422,14 @@ func (e HeaderValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHeaderValue.%s: %s%s",
+		"invalid %sHttpTriggerContext.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HeaderValueValidationError{}
+var _ error = HttpTriggerContextValidationError{}
 
 var _ interface {
 	Field() string
@@ -1917,43 +3437,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HeaderValueValidationError{}
+} = HttpTriggerContextValidationError{}
 
-// Validate checks the field values on QueryValue with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *QueryValue) Validate() error {
+// Validate checks the field values on TopicTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopicTriggerContext) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryValue with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in QueryValueMultiError, or
-// nil if none found.
-func (m *QueryValue) ValidateAll() error {
+// ValidateAll checks the field values on TopicTriggerContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopicTriggerContextMultiError, or nil if none found.
+func (m *TopicTriggerContext) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryValue) validate(all bool) error {
+func (m *TopicTriggerContext) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Topic
+
+	// no validation rules for Id
+
+	// no validation rules for PayloadType
+
+	// no validation rules for Attributes
+
+	if all {
+		switch v := interface{}(m.GetPublishTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TopicTriggerContextValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TopicTriggerContextValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TopicTriggerContextValidationError{
+				field:  "PublishTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeliveryAttempt
+
 	if len(errors) > 0 {
-		return QueryValueMultiError(errors)
+		return TopicTriggerContextMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryValueMultiError is an error wrapping multiple validation errors
-// returned by QueryValue.ValidateAll() if the designated constraints aren't met.
-type QueryValueMultiError []error
+// TopicTriggerContextMultiError is an error wrapping multiple validation
+// errors returned by TopicTriggerContext.ValidateAll() if the designated
+// constraints aren't met.
+type TopicTriggerContextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryValueMultiError) Error() string {
+func (m TopicTriggerContextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1962,11 +3522,11 @@ func (m QueryValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryValueMultiError) AllErrors() []error { return m }
+func (m TopicTriggerContextMultiError) AllErrors() []error { return m }
 
-// QueryValueValidationError is the validation error returned by
-// QueryValue.Validate if the designated constraints aren't met.
-type QueryValueValidationError struct {
+// TopicTriggerContextValidationError is the validation error returned by
+// TopicTriggerContext.Validate if the designated constraints aren't met.
+type TopicTriggerContextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1974,22 +3534,24 @@ type QueryValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryValueValidationError) Field() string { return e.field }
+func (e TopicTriggerContextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryValueValidationError) Reason() string { return e.reason }
+func (e TopicTriggerContextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryValueValidationError) Cause() error { return e.cause }
+func (e TopicTriggerContextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryValueValidationError) Key() bool { return e.key }
+func (e TopicTriggerContextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryValueValidationError) ErrorName() string { return "QueryValueValidationError" }
+func (e TopicTriggerContextValidationError) ErrorName() string {
+	return "TopicTriggerContextValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e QueryValueValidationError) Error() string {
+func (e TopicTriggerContextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2001,14 +3563,14 @@ func (e QueryValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryValue.%s: %s%s",
+		"invalid %sTopicTriggerContext.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryValueValidationError{}
+var _ error = TopicTriggerContextValidationError{}
 
 var _ interface {
 	Field() string
@@ -2016,83 +3578,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryValueValidationError{}
+} = TopicTriggerContextValidationError{}
 
-// Validate checks the field values on HttpTriggerContext with the rules
+// Validate checks the field values on WebsocketTriggerContext with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *HttpTriggerContext) Validate() error {
+func (m *WebsocketTriggerContext) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HttpTriggerContext with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WebsocketTriggerContext with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// HttpTriggerContextMultiError, or nil if none found.
-func (m *HttpTriggerContext) ValidateAll() error {
+// WebsocketTriggerContextMultiError, or nil if none found.
+func (m *WebsocketTriggerContext) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HttpTriggerContext) validate(all bool) error {
+func (m *WebsocketTriggerContext) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Method
-
-	// no validation rules for Path
-
-	// no validation rules for HeadersOld
-
-	// no validation rules for QueryParamsOld
-
-	{
-		sorted_keys := make([]string, len(m.GetHeaders()))
-		i := 0
-		for key := range m.GetHeaders() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetHeaders()[key]
-			_ = val
-
-			// no validation rules for Headers[key]
+	// no validation rules for Socket
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
-							field:  fmt.Sprintf("Headers[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return HttpTriggerContextValidationError{
-						field:  fmt.Sprintf("Headers[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Event
 
-		}
-	}
+	// no validation rules for ConnectionId
 
 	{
 		sorted_keys := make([]string, len(m.GetQueryParams()))
@@ -2112,7 +3626,7 @@ func (m *HttpTriggerContext) validate(all bool) error {
 				switch v := interface{}(val).(type) {
 				case interface{ ValidateAll() error }:
 					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
+						errors = append(errors, WebsocketTriggerContextValidationError{
 							field:  fmt.Sprintf("QueryParams[%v]", key),
 							reason: "embedded message failed validation",
 							cause:  err,
@@ -2120,7 +3634,7 @@ func (m *HttpTriggerContext) validate(all bool) error {
 					}
 				case interface{ Validate() error }:
 					if err := v.Validate(); err != nil {
-						errors = append(errors, HttpTriggerContextValidationError{
+						errors = append(errors, WebsocketTriggerContextValidationError{
 							field:  fmt.Sprintf("QueryParams[%v]", key),
 							reason: "embedded message failed validation",
 							cause:  err,
@@ -2129,7 +3643,7 @@ func (m *HttpTriggerContext) validate(all bool) error {
 				}
 			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
 				if err := v.Validate(); err != nil {
-					return HttpTriggerContextValidationError{
+					return WebsocketTriggerContextValidationError{
 						field:  fmt.Sprintf("QueryParams[%v]", key),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2140,22 +3654,20 @@ func (m *HttpTriggerContext) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for PathParams
-
 	if len(errors) > 0 {
-		return HttpTriggerContextMultiError(errors)
+		return WebsocketTriggerContextMultiError(errors)
 	}
 
 	return nil
 }
 
-// HttpTriggerContextMultiError is an error wrapping multiple validation errors
-// returned by HttpTriggerContext.ValidateAll() if the designated constraints
-// aren't met.
-type HttpTriggerContextMultiError []error
+// WebsocketTriggerContextMultiError is an error wrapping multiple validation
+// errors returned by WebsocketTriggerContext.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketTriggerContextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HttpTriggerContextMultiError) Error() string {
+func (m WebsocketTriggerContextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2164,11 +3676,11 @@ func (m HttpTriggerContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HttpTriggerContextMultiError) AllErrors() []error { return m }
+func (m WebsocketTriggerContextMultiError) AllErrors() []error { return m }
 
-// HttpTriggerContextValidationError is the validation error returned by
-// HttpTriggerContext.Validate if the designated constraints aren't met.
-type HttpTriggerContextValidationError struct {
+// WebsocketTriggerContextValidationError is the validation error returned by
+// WebsocketTriggerContext.Validate if the designated constraints aren't met.
+type WebsocketTriggerContextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2176,24 +3688,24 @@ type HttpTriggerContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e HttpTriggerContextValidationError) Field() string { return e.field }
+func (e WebsocketTriggerContextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HttpTriggerContextValidationError) Reason() string { return e.reason }
+func (e WebsocketTriggerContextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HttpTriggerContextValidationError) Cause() error { return e.cause }
+func (e WebsocketTriggerContextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HttpTriggerContextValidationError) Key() bool { return e.key }
+func (e WebsocketTriggerContextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HttpTriggerContextValidationError) ErrorName() string {
-	return "HttpTriggerContextValidationError"
+func (e WebsocketTriggerContextValidationError) ErrorName() string {
+	return "WebsocketTriggerContextValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e HttpTriggerContextValidationError) Error() string {
+func (e WebsocketTriggerContextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2205,14 +3717,14 @@ func (e HttpTriggerContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHttpTriggerContext.%s: %s%s",
+		"invalid %sWebsocketTriggerContext.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HttpTriggerContextValidationError{}
+var _ error = WebsocketTriggerContextValidationError{}
 
 var _ interface {
 	Field() string
@@ -2220,46 +3732,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HttpTriggerContextValidationError{}
+} = WebsocketTriggerContextValidationError{}
 
-// Validate checks the field values on TopicTriggerContext with the rules
+// Validate checks the field values on ScheduleTriggerContext with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TopicTriggerContext) Validate() error {
+func (m *ScheduleTriggerContext) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TopicTriggerContext with the rules
+// ValidateAll checks the field values on ScheduleTriggerContext with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TopicTriggerContextMultiError, or nil if none found.
-func (m *TopicTriggerContext) ValidateAll() error {
+// ScheduleTriggerContextMultiError, or nil if none found.
+func (m *ScheduleTriggerContext) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TopicTriggerContext) validate(all bool) error {
+func (m *ScheduleTriggerContext) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetScheduledTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleTriggerContextValidationError{
+					field:  "ScheduledTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleTriggerContextValidationError{
+					field:  "ScheduledTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleTriggerContextValidationError{
+				field:  "ScheduledTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleTriggerContextValidationError{
+					field:  "FireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleTriggerContextValidationError{
+					field:  "FireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleTriggerContextValidationError{
+				field:  "FireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Topic
+	// no validation rules for Cadence
 
 	if len(errors) > 0 {
-		return TopicTriggerContextMultiError(errors)
+		return ScheduleTriggerContextMultiError(errors)
 	}
 
 	return nil
 }
 
-// TopicTriggerContextMultiError is an error wrapping multiple validation
-// errors returned by TopicTriggerContext.ValidateAll() if the designated
+// ScheduleTriggerContextMultiError is an error wrapping multiple validation
+// errors returned by ScheduleTriggerContext.ValidateAll() if the designated
 // constraints aren't met.
-type TopicTriggerContextMultiError []error
+type ScheduleTriggerContextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TopicTriggerContextMultiError) Error() string {
+func (m ScheduleTriggerContextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2268,11 +3840,11 @@ func (m TopicTriggerContextMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TopicTriggerContextMultiError) AllErrors() []error { return m }
+func (m ScheduleTriggerContextMultiError) AllErrors() []error { return m }
 
-// TopicTriggerContextValidationError is the validation error returned by
-// TopicTriggerContext.Validate if the designated constraints aren't met.
-type TopicTriggerContextValidationError struct {
+// ScheduleTriggerContextValidationError is the validation error returned by
+// ScheduleTriggerContext.Validate if the designated constraints aren't met.
+type ScheduleTriggerContextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2280,24 +3852,24 @@ type TopicTriggerContextValidationError struct {
 }
 
 // Field function returns field value.
-func (e TopicTriggerContextValidationError) Field() string { return e.field }
+func (e ScheduleTriggerContextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TopicTriggerContextValidationError) Reason() string { return e.reason }
+func (e ScheduleTriggerContextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TopicTriggerContextValidationError) Cause() error { return e.cause }
+func (e ScheduleTriggerContextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TopicTriggerContextValidationError) Key() bool { return e.key }
+func (e ScheduleTriggerContextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TopicTriggerContextValidationError) ErrorName() string {
-	return "TopicTriggerContextValidationError"
+func (e ScheduleTriggerContextValidationError) ErrorName() string {
+	return "ScheduleTriggerContextValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TopicTriggerContextValidationError) Error() string {
+func (e ScheduleTriggerContextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2309,14 +3881,14 @@ func (e TopicTriggerContextValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTopicTriggerContext.%s: %s%s",
+		"invalid %sScheduleTriggerContext.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TopicTriggerContextValidationError{}
+var _ error = ScheduleTriggerContextValidationError{}
 
 var _ interface {
 	Field() string
@@ -2324,7 +3896,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TopicTriggerContextValidationError{}
+} = ScheduleTriggerContextValidationError{}
 
 // Validate checks the field values on TriggerResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -2414,6 +3986,68 @@ func (m *TriggerResponse) validate(all bool) error {
 			}
 		}
 
+	case *TriggerResponse_Websocket:
+
+		if all {
+			switch v := interface{}(m.GetWebsocket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Websocket",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebsocket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerResponseValidationError{
+					field:  "Websocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerResponse_Schedule:
+
+		if all {
+			switch v := interface{}(m.GetSchedule()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerResponseValidationError{
+						field:  "Schedule",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
@@ -2566,6 +4200,8 @@ func (m *HttpResponseContext) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for StreamedBody
+
 	if len(errors) > 0 {
 		return HttpResponseContextMultiError(errors)
 	}
@@ -2749,3 +4385,211 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TopicResponseContextValidationError{}
+
+// Validate checks the field values on WebsocketResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebsocketResponseContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebsocketResponseContext with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebsocketResponseContextMultiError, or nil if none found.
+func (m *WebsocketResponseContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebsocketResponseContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return WebsocketResponseContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebsocketResponseContextMultiError is an error wrapping multiple validation
+// errors returned by WebsocketResponseContext.ValidateAll() if the designated
+// constraints aren't met.
+type WebsocketResponseContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebsocketResponseContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebsocketResponseContextMultiError) AllErrors() []error { return m }
+
+// WebsocketResponseContextValidationError is the validation error returned by
+// WebsocketResponseContext.Validate if the designated constraints aren't met.
+type WebsocketResponseContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebsocketResponseContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebsocketResponseContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebsocketResponseContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebsocketResponseContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebsocketResponseContextValidationError) ErrorName() string {
+	return "WebsocketResponseContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebsocketResponseContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebsocketResponseContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebsocketResponseContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebsocketResponseContextValidationError{}
+
+// Validate checks the field values on ScheduleResponseContext with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleResponseContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleResponseContext with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleResponseContextMultiError, or nil if none found.
+func (m *ScheduleResponseContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleResponseContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return ScheduleResponseContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleResponseContextMultiError is an error wrapping multiple validation
+// errors returned by ScheduleResponseContext.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleResponseContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleResponseContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleResponseContextMultiError) AllErrors() []error { return m }
+
+// ScheduleResponseContextValidationError is the validation error returned by
+// ScheduleResponseContext.Validate if the designated constraints aren't met.
+type ScheduleResponseContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleResponseContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleResponseContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleResponseContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleResponseContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleResponseContextValidationError) ErrorName() string {
+	return "ScheduleResponseContextValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleResponseContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleResponseContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleResponseContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleResponseContextValidationError{}
@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: websocket/v1/websocket.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WebsocketServiceClient is the client API for WebsocketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebsocketServiceClient interface {
+	// Send a message to a connected websocket client
+	Send(ctx context.Context, in *WebsocketSendRequest, opts ...grpc.CallOption) (*WebsocketSendResponse, error)
+	// Close a connected websocket client's connection
+	Close(ctx context.Context, in *WebsocketCloseRequest, opts ...grpc.CallOption) (*WebsocketCloseResponse, error)
+}
+
+type websocketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebsocketServiceClient(cc grpc.ClientConnInterface) WebsocketServiceClient {
+	return &websocketServiceClient{cc}
+}
+
+func (c *websocketServiceClient) Send(ctx context.Context, in *WebsocketSendRequest, opts ...grpc.CallOption) (*WebsocketSendResponse, error) {
+	out := new(WebsocketSendResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *websocketServiceClient) Close(ctx context.Context, in *WebsocketCloseRequest, opts ...grpc.CallOption) (*WebsocketCloseResponse, error) {
+	out := new(WebsocketCloseResponse)
+	err := c.cc.Invoke(ctx, "/nitric.websocket.v1.WebsocketService/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebsocketServiceServer is the server API for WebsocketService service.
+// All implementations must embed UnimplementedWebsocketServiceServer
+// for forward compatibility
+type WebsocketServiceServer interface {
+	// Send a message to a connected websocket client
+	Send(context.Context, *WebsocketSendRequest) (*WebsocketSendResponse, error)
+	// Close a connected websocket client's connection
+	Close(context.Context, *WebsocketCloseRequest) (*WebsocketCloseResponse, error)
+	mustEmbedUnimplementedWebsocketServiceServer()
+}
+
+// UnimplementedWebsocketServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWebsocketServiceServer struct {
+}
+
+func (UnimplementedWebsocketServiceServer) Send(context.Context, *WebsocketSendRequest) (*WebsocketSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedWebsocketServiceServer) Close(context.Context, *WebsocketCloseRequest) (*WebsocketCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedWebsocketServiceServer) mustEmbedUnimplementedWebsocketServiceServer() {}
+
+// UnsafeWebsocketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebsocketServiceServer will
+// result in compilation errors.
+type UnsafeWebsocketServiceServer interface {
+	mustEmbedUnimplementedWebsocketServiceServer()
+}
+
+func RegisterWebsocketServiceServer(s grpc.ServiceRegistrar, srv WebsocketServiceServer) {
+	s.RegisterService(&WebsocketService_ServiceDesc, srv)
+}
+
+func _WebsocketService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).Send(ctx, req.(*WebsocketSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebsocketService_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServiceServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.websocket.v1.WebsocketService/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServiceServer).Close(ctx, req.(*WebsocketCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebsocketService_ServiceDesc is the grpc.ServiceDesc for WebsocketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebsocketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.websocket.v1.WebsocketService",
+	HandlerType: (*WebsocketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _WebsocketService_Send_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _WebsocketService_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "websocket/v1/websocket.proto",
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FormatArg - formats a plugin error argument for logging, only struct fields tagged with log are included
+func FormatArg(arg interface{}) string {
+	value := getValue(arg)
+
+	if value.Kind() == reflect.Struct {
+		str := "{"
+		for i := 0; i < value.NumField(); i++ {
+			fieldType := value.Type().Field(i)
+			tag := fieldType.Tag.Get("log")
+			if tag == "" || tag == "-" {
+				continue
+			}
+
+			if len(str) > 1 {
+				str += ", "
+			}
+
+			field := value.Field(i)
+			str += fieldType.Name + ": " + FormatArg(field.Interface())
+		}
+		str += "}"
+
+		return str
+	} else if value.Kind() == reflect.Map {
+		str := "{"
+
+		for k, v := range arg.(map[string]interface{}) {
+			if len(str) > 1 {
+				str += ", "
+			}
+			str += fmt.Sprintf("%v", k) + ": " + FormatArg(v)
+		}
+
+		str += "}"
+
+		return str
+	} else {
+		return fmt.Sprintf("%v", arg)
+	}
+}
+
+func getValue(x interface{}) reflect.Value {
+	val := reflect.ValueOf(x)
+
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	return val
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
+)
+
+// Fields - structured fields attached to log lines
+type Fields = logrus.Fields
+
+// Field names shared by every component, so lines emitted during a trigger can be correlated
+const (
+	TraceIDKey    = "trace_id"
+	SpanIDKey     = "span_id"
+	RequestIDKey  = "request_id"
+	WorkerTypeKey = "worker_type"
+	ServiceKey    = "service"
+	PluginKey     = "plugin"
+	PluginArgsKey = "plugin_args"
+	CodeKey       = "code"
+	ComponentKey  = "component"
+)
+
+// Format - the encoding of log lines
+type Format string
+
+const (
+	FormatJSON   Format = "json"
+	FormatLogfmt Format = "logfmt"
+)
+
+type Options struct {
+	// One of trace, debug, info, warn, error, fatal or panic
+	Level string
+	// One of json or logfmt
+	Format Format
+	// Where lines are written, defaults to stderr
+	Output io.Writer
+}
+
+// New - creates a logger with the given level and format
+func New(opts *Options) (*logrus.Entry, error) {
+	l := logrus.New()
+
+	level, err := logrus.ParseLevel(opts.Level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level %s: %w", opts.Level, err)
+	}
+	l.SetLevel(level)
+
+	switch Format(strings.ToLower(string(opts.Format))) {
+	case FormatJSON:
+		l.SetFormatter(&logrus.JSONFormatter{})
+	case FormatLogfmt:
+		l.SetFormatter(&logrus.TextFormatter{DisableColors: true, FullTimestamp: true})
+	default:
+		return nil, fmt.Errorf("invalid log format %s, expected %s or %s", opts.Format, FormatJSON, FormatLogfmt)
+	}
+
+	if opts.Output != nil {
+		l.SetOutput(opts.Output)
+	} else {
+		l.SetOutput(os.Stderr)
+	}
+
+	return logrus.NewEntry(l), nil
+}
+
+var defaultLogger atomic.Value
+
+func init() {
+	l, _ := New(&Options{Level: "info", Format: FormatJSON})
+	SetDefault(l)
+}
+
+// SetDefault - sets the logger used where no logger has been provided, e.g. by plugins and gateways
+func SetDefault(l *logrus.Entry) {
+	defaultLogger.Store(l)
+}
+
+// Default - returns the logger set with SetDefault, logging json at info level if not set
+func Default() *logrus.Entry {
+	return defaultLogger.Load().(*logrus.Entry)
+}
+
+type contextKey struct{}
+
+// WithContext - returns a copy of ctx carrying the given logger
+func WithContext(ctx context.Context, l *logrus.Entry) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// WithFields - returns a copy of ctx whose logger includes the given fields
+func WithFields(ctx context.Context, fields Fields) context.Context {
+	return WithContext(ctx, fromContext(ctx).WithFields(fields))
+}
+
+func fromContext(ctx context.Context) *logrus.Entry {
+	if l, ok := ctx.Value(contextKey{}).(*logrus.Entry); ok {
+		return l
+	}
+
+	return Default()
+}
+
+// FromContext - returns the logger carried by ctx, or the default logger, including the IDs of the active span if any
+func FromContext(ctx context.Context) *logrus.Entry {
+	l := fromContext(ctx).WithContext(ctx)
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		l = l.WithFields(Fields{
+			TraceIDKey: sc.TraceID().String(),
+			SpanIDKey:  sc.SpanID().String(),
+		})
+	}
+
+	return l
+}
+
+// RequestID - returns the request ID carried by the logger of ctx, empty if not set
+func RequestID(ctx context.Context) string {
+	id, _ := fromContext(ctx).Data[RequestIDKey].(string)
+
+	return id
+}
+
+// WithError - adds the error to the logger, along with its plugin scope and code if it is a plugin error
+func WithError(l *logrus.Entry, err error) *logrus.Entry {
+	l = l.WithError(err)
+
+	var pe *errors.PluginError
+	if errors.As(err, &pe) {
+		fields := Fields{
+			CodeKey: pe.Code.String(),
+		}
+
+		if pe.Plugin != "" {
+			fields[PluginKey] = pe.Plugin
+		}
+
+		if len(pe.Args) > 0 {
+			args := make(map[string]string, len(pe.Args))
+			for k, v := range pe.Args {
+				args[k] = FormatArg(v)
+			}
+			fields[PluginArgsKey] = args
+		}
+
+		l = l.WithFields(fields)
+	}
+
+	return l
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/core/pkg/plugins/errors/codes"
+)
+
+type secretArg struct {
+	Name  string `log:"name"`
+	Value string
+}
+
+func lastLine(buf *bytes.Buffer) map[string]interface{} {
+	line := map[string]interface{}{}
+	Expect(json.Unmarshal(buf.Bytes(), &line)).To(Succeed())
+
+	return line
+}
+
+var _ = Describe("Logger", func() {
+	Context("New", func() {
+		When("the level is invalid", func() {
+			It("should return an error", func() {
+				_, err := logger.New(&logger.Options{Level: "loud", Format: logger.FormatJSON})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the format is invalid", func() {
+			It("should return an error", func() {
+				_, err := logger.New(&logger.Options{Level: "info", Format: "xml"})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the format is logfmt", func() {
+			It("should write key=value lines", func() {
+				buf := &bytes.Buffer{}
+				l, err := logger.New(&logger.Options{Level: "info", Format: logger.FormatLogfmt, Output: buf})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				l.WithField(logger.RequestIDKey, "abc").Info("hello")
+
+				Expect(buf.String()).To(ContainSubstring("msg=hello"))
+				Expect(buf.String()).To(ContainSubstring("request_id=abc"))
+			})
+		})
+
+		When("lines are below the level", func() {
+			It("should not write them", func() {
+				buf := &bytes.Buffer{}
+				l, err := logger.New(&logger.Options{Level: "warn", Format: logger.FormatJSON, Output: buf})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				l.Info("hello")
+
+				Expect(buf.Len()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("FromContext", func() {
+		var buf *bytes.Buffer
+
+		BeforeEach(func() {
+			buf = &bytes.Buffer{}
+			l, err := logger.New(&logger.Options{Level: "info", Format: logger.FormatJSON, Output: buf})
+			Expect(err).ShouldNot(HaveOccurred())
+			logger.SetDefault(l)
+		})
+
+		When("the context has fields", func() {
+			It("should include them and return the request ID", func() {
+				ctx := logger.WithFields(context.TODO(), logger.Fields{logger.RequestIDKey: "req-1"})
+
+				logger.FromContext(ctx).Info("hello")
+
+				Expect(lastLine(buf)).To(HaveKeyWithValue(logger.RequestIDKey, "req-1"))
+				Expect(logger.RequestID(ctx)).To(Equal("req-1"))
+			})
+		})
+
+		When("the context has an active span", func() {
+			It("should include its trace and span IDs", func() {
+				traceId, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+				spanId, _ := trace.SpanIDFromHex("0102030405060708")
+				ctx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+					TraceID: traceId,
+					SpanID:  spanId,
+				}))
+
+				logger.FromContext(ctx).Info("hello")
+
+				line := lastLine(buf)
+				Expect(line).To(HaveKeyWithValue(logger.TraceIDKey, traceId.String()))
+				Expect(line).To(HaveKeyWithValue(logger.SpanIDKey, spanId.String()))
+			})
+		})
+	})
+
+	Context("WithError", func() {
+		var buf *bytes.Buffer
+
+		BeforeEach(func() {
+			buf = &bytes.Buffer{}
+			l, err := logger.New(&logger.Options{Level: "info", Format: logger.FormatJSON, Output: buf})
+			Expect(err).ShouldNot(HaveOccurred())
+			logger.SetDefault(l)
+		})
+
+		When("the error is a plugin error", func() {
+			It("should include its code, plugin and loggable args", func() {
+				newErr := errors.ErrorsWithScope("Queue.Send", map[string]interface{}{
+					"secret": &secretArg{Name: "db", Value: "hunter2"},
+				})
+				err := fmt.Errorf("wrapped: %w", newErr(codes.NotFound, "missing", nil))
+
+				logger.WithError(logger.Default(), err).Error("failed")
+
+				line := lastLine(buf)
+				Expect(line).To(HaveKeyWithValue(logger.CodeKey, codes.NotFound.String()))
+				Expect(line).To(HaveKeyWithValue(logger.PluginKey, "Queue.Send"))
+				Expect(line).To(HaveKeyWithValue(logger.PluginArgsKey, HaveKeyWithValue("secret", "{Name: db}")))
+			})
+		})
+
+		When("the error is not a plugin error", func() {
+			It("should only include the error", func() {
+				logger.WithError(logger.Default(), fmt.Errorf("boom")).Error("failed")
+
+				line := lastLine(buf)
+				Expect(line).To(HaveKeyWithValue("error", "boom"))
+				Expect(line).NotTo(HaveKey(logger.PluginKey))
+			})
+		})
+	})
+})
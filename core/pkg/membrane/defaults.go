@@ -15,10 +15,10 @@
 package membrane
 
 import (
-	"log"
 	"os"
 	"strings"
 
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/utils"
 )
 
@@ -30,7 +30,7 @@ func DefaultMembraneOptions() *MembraneOptions {
 	} else {
 		options.ChildCommand = strings.Fields(utils.GetEnv("INVOKE", ""))
 		if len(options.ChildCommand) > 0 {
-			log.Default().Println("Warning: use of INVOKE environment variable is deprecated and may be removed in a future version")
+			logger.Default().Warn("use of INVOKE environment variable is deprecated and may be removed in a future version")
 		}
 	}
 
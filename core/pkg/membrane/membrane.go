@@ -16,43 +16,102 @@ package membrane
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"net"
+	"os"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	grpc2 "github.com/nitrictech/nitric/core/pkg/adapters/grpc"
+	"github.com/nitrictech/nitric/core/pkg/admin"
 	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	"github.com/nitrictech/nitric/core/pkg/plugins/document"
 	"github.com/nitrictech/nitric/core/pkg/plugins/events"
 	"github.com/nitrictech/nitric/core/pkg/plugins/gateway"
 	"github.com/nitrictech/nitric/core/pkg/plugins/queue"
 	"github.com/nitrictech/nitric/core/pkg/plugins/secret"
 	"github.com/nitrictech/nitric/core/pkg/plugins/storage"
+	"github.com/nitrictech/nitric/core/pkg/plugins/websocket"
 	"github.com/nitrictech/nitric/core/pkg/pm"
 	"github.com/nitrictech/nitric/core/pkg/providers/common"
+	"github.com/nitrictech/nitric/core/pkg/schedule"
+	"github.com/nitrictech/nitric/core/pkg/security"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/utils"
 	"github.com/nitrictech/nitric/core/pkg/worker"
 )
 
 type MembraneOptions struct {
+	// The address to bind the service interfaces to, either host:port or unix:///path/to/socket
 	ServiceAddress string
-	// The address the child will be listening on
+	// Shared token clients must present to the service API, this is passed to the child as SERVICE_TOKEN
+	ServiceToken string
+	// Certificate and key used to serve the service API over TLS
+	ServiceTLSCertFile string
+	ServiceTLSKeyFile  string
+	// CA used to verify client certificates, setting this enables mutual TLS
+	ServiceTLSClientCAFile string
+	// The address the child will be listening on, either host:port or unix:///path/to/socket
 	ChildAddress string
 	// The command that will be used to invoke the child process
 	ChildCommand []string
+	// Additional named child processes, started in order after ChildCommand.
+	// Each is passed the same SERVICE_ADDRESS, CHILD_ADDRESS and SERVICE_TOKEN as the child process.
+	ChildProcesses []pm.ProcessOptions
 	// Commands that will be started before all others
 	PreCommands [][]string
 
 	// The total time to wait for the child process to be available in seconds
 	ChildTimeoutSeconds int
+	// Whether the child and pre-processes are restarted when they exit, with exponential backoff
+	ChildRestartPolicy pm.RestartPolicy
+	// The number of times a process may be restarted before the membrane exits
+	ChildMaxRestarts int
+	// In FaaS mode, the in-flight triggers per child process above which a replica of the child is started,
+	// up to the maximum workers of the pool. Scaling is disabled if 0.
+	ScaleThreshold int
+	// The time in seconds without in-flight triggers after which a replica is stopped
+	ScaleIdleSeconds int
+	// On shutdown, the time in seconds to wait for in-flight triggers to complete before the child is sent SIGTERM,
+	// and then for the child to exit before it is sent SIGKILL
+	ShutdownTimeoutSeconds int
+	// In FaaS mode, the time in seconds a trigger waits for a worker at the maximum concurrency it declared
+	// to finish another, before being rejected as overloaded. Rejected immediately if negative.
+	ConcurrencyWaitSeconds int
+	// In FaaS mode, the interval in seconds to ping workers that support heartbeats at. Heartbeats are disabled if negative.
+	HeartbeatIntervalSeconds int
+	// The time in seconds without messages from a worker after which it is unresponsive and removed from the pool
+	HeartbeatTimeoutSeconds int
+	// Restart the child process when one of its workers is unresponsive
+	RestartUnresponsiveChild bool
+	// In FaaS mode, fire the schedules registered by the child at their rate or cron cadence,
+	// for deployments without a cloud scheduler
+	RunSchedules bool
+	// The maximum random delay in seconds added to each schedule firing
+	ScheduleJitterSeconds int
+	// Fire a schedule while its previous firing is still being handled, otherwise the firing is skipped
+	ScheduleAllowOverlap bool
+	// The time in seconds a schedule firing may take to be handled, defaults to the schedule's interval if 0
+	ScheduleTimeoutSeconds int
 
 	DocumentPlugin  document.DocumentService
 	EventsPlugin    events.EventService
@@ -61,12 +120,32 @@ type MembraneOptions struct {
 	GatewayPlugin   gateway.GatewayService
 	SecretPlugin    secret.SecretService
 	ResourcesPlugin common.ResourceService
+	// Defaults to the GatewayPlugin if it implements the WebsocketService
+	WebsocketPlugin websocket.WebsocketService
+	// Creates the EventsPlugin from the membrane's worker pool if it is nil, for plugins delivering events to the pool's workers
+	CreateEventsPlugin func(pool worker.WorkerPool) (events.EventService, error)
 
 	CreateTracerProvider func(ctx context.Context) (*sdktrace.TracerProvider, error)
-
-	SuppressLogs            bool
+	// Metrics are exported through the same otelcol pre-process as traces
+	CreateMeterProvider func(ctx context.Context) (*sdkmetric.MeterProvider, error)
+
+	SuppressLogs bool
+	// The minimum level of log lines to emit, e.g. debug, info, warn or error
+	LogLevel string
+	// The encoding of log lines, json or logfmt
+	LogFormat               logger.Format
 	TolerateMissingServices bool
 
+	// Deny plugin calls that are not permitted by the policies declared via the ResourceService
+	EnforcePolicies bool
+
+	// Validate JWTs for api routes against the security definitions declared via the ResourceService
+	// Only required where the gateway does not already enforce api security, e.g. local and container deployments
+	EnforceApiSecurity bool
+
+	// The address to serve health, readiness and metrics endpoints on, the endpoints are not served if empty
+	AdminAddress string
+
 	// The operating mode of the membrane
 	Mode *Mode
 
@@ -80,75 +159,119 @@ type Membrane struct {
 	// proxyAddress string
 	// Address & port to bind the membrane service interfaces to
 	serviceAddress string
+	// Token required to call the membrane service interfaces, empty if not required
+	serviceToken string
+	// TLS configuration for the membrane service interfaces, nil if served in plaintext
+	serviceTLSConfig *tls.Config
 	// The address the child will be listening on
 	childAddress string
 
-	// The URL (including protocol, the child process can be reached on)
-	childUrl string
-
 	processManager       pm.ProcessManager
 	tracerProvider       *sdktrace.TracerProvider
 	createTracerProvider func(ctx context.Context) (*sdktrace.TracerProvider, error)
+	meterProvider        *sdkmetric.MeterProvider
+	createMeterProvider  func(ctx context.Context) (*sdkmetric.MeterProvider, error)
+	otelMetrics          *metrics.OtelMetrics
 
 	childTimeoutSeconds int
+	shutdownTimeout     time.Duration
+	concurrencyWait     time.Duration
+	heartbeatInterval   time.Duration
+	heartbeatTimeout    time.Duration
+	// Restarts the user processes when a worker is unresponsive
+	restartUnresponsive bool
+	// Guards lastRestart, so the workers of the same unresponsive child only restart it once
+	restartLock sync.Mutex
+	lastRestart time.Time
+	// Tracks the triggers being handled, so they can be drained on shutdown
+	inFlight *worker.InFlightTracker
+	// Scales replicas of the child process, nil if scaling is disabled
+	scaler     *scaler
+	scalerDone chan struct{}
+	// Fires schedules, nil unless the membrane runs schedules
+	scheduler     *schedule.Scheduler
+	schedulerDone chan struct{}
 
 	// Configured plugins
-	documentPlugin document.DocumentService
-	eventsPlugin   events.EventService
-	storagePlugin  storage.StorageService
-	gatewayPlugin  gateway.GatewayService
-	queuePlugin    queue.QueueService
-	secretPlugin   secret.SecretService
-	resourcePlugin common.ResourceService
+	documentPlugin  document.DocumentService
+	eventsPlugin    events.EventService
+	storagePlugin   storage.StorageService
+	gatewayPlugin   gateway.GatewayService
+	queuePlugin     queue.QueueService
+	secretPlugin    secret.SecretService
+	resourcePlugin  common.ResourceService
+	websocketPlugin websocket.WebsocketService
 
 	// Tolerate if provider specific plugins aren't available for some services.
 	// Not this does not include the gateway service
 	tolerateMissingServices bool
 
-	// Suppress println statements in the membrane server
-	suppressLogs bool
+	// Permissions built from declared policies, nil if policies are not enforced
+	permissions *grpc2.PermissionTable
+
+	// Declared apis, nil if api security is not enforced
+	apiDefinitions *security.ApiDefinitions
+
+	log *logrus.Entry
 
 	// Handler operating mode, e.g. FaaS or HTTP Proxy. Governs how incoming triggers are translated.
 	mode Mode
 
 	grpcServer *grpc.Server
 
+	// Serves health, readiness and metrics endpoints, nil if not enabled
+	adminServer *admin.Server
+	metrics     *metrics.PrometheusMetrics
+
 	// Worker pool
 	pool worker.WorkerPool
+	// The minimum number of workers required by the pool, when known
+	minWorkers int
+	// Set once the minimum number of workers have registered
+	workersReady int32
 }
 
-func (s *Membrane) log(msg string) {
-	if !s.suppressLogs {
-		log.Default().Println(msg)
+// serviceServerOptions - options shared by all plugin service servers
+func (s *Membrane) serviceServerOptions() []grpc2.ServiceServerOption {
+	opts := []grpc2.ServiceServerOption{}
+
+	if s.permissions != nil {
+		opts = append(opts, grpc2.WithPolicyEnforcer(s.permissions))
 	}
+
+	return opts
 }
 
 func (s *Membrane) createSecretServer() v1.SecretServiceServer {
-	return grpc2.NewSecretServer(s.secretPlugin)
+	return grpc2.NewSecretServer(s.secretPlugin, s.serviceServerOptions()...)
 }
 
 // Create a new Nitric Document Server
 func (s *Membrane) createDocumentServer() v1.DocumentServiceServer {
-	return grpc2.NewDocumentServer(s.documentPlugin)
+	return grpc2.NewDocumentServer(s.documentPlugin, s.serviceServerOptions()...)
 }
 
 // Create a new Nitric events Server
 func (s *Membrane) createEventsServer() v1.EventServiceServer {
-	return grpc2.NewEventServiceServer(s.eventsPlugin)
+	return grpc2.NewEventServiceServer(s.eventsPlugin, s.serviceServerOptions()...)
 }
 
 // Create a new Nitric Topic Server
 func (s *Membrane) createTopicServer() v1.TopicServiceServer {
-	return grpc2.NewTopicServiceServer(s.eventsPlugin)
+	return grpc2.NewTopicServiceServer(s.eventsPlugin, s.serviceServerOptions()...)
 }
 
 // Create a new Nitric Storage Server
 func (s *Membrane) createStorageServer() v1.StorageServiceServer {
-	return grpc2.NewStorageServiceServer(s.storagePlugin)
+	return grpc2.NewStorageServiceServer(s.storagePlugin, s.serviceServerOptions()...)
+}
+
+func (s *Membrane) createWebsocketServer() v1.WebsocketServiceServer {
+	return grpc2.NewWebsocketServer(s.websocketPlugin, s.serviceServerOptions()...)
 }
 
 func (s *Membrane) createQueueServer() v1.QueueServiceServer {
-	return grpc2.NewQueueServiceServer(s.queuePlugin)
+	return grpc2.NewQueueServiceServer(s.queuePlugin, s.serviceServerOptions()...)
 }
 
 // Start the membrane
@@ -158,16 +281,23 @@ func (s *Membrane) Start() error {
 	}
 
 	var opts []grpc.ServerOption
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+
+	if s.serviceTLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.serviceTLSConfig)))
+	}
 
 	if s.createTracerProvider != nil {
 		tp, err := s.createTracerProvider(context.Background())
 		if err != nil {
-			s.log(fmt.Sprintf("traceProvider %v", err))
+			s.log.WithError(err).Error("could not create tracer provider")
 			return err
 		}
 
 		if tp != nil {
-			s.log(fmt.Sprintf("traceProvider connected"))
+			s.log.Info("tracer provider connected")
+			s.tracerProvider = tp
 			otel.SetTracerProvider(tp)
 		}
 
@@ -175,12 +305,42 @@ func (s *Membrane) Start() error {
 			otelgrpc.WithPropagators(propagation.TraceContext{}),
 		}
 
-		opts = append(opts,
-			grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor(interceptorOpts...)),
-			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor(interceptorOpts...)),
-		)
+		unaryInterceptors = append(unaryInterceptors, otelgrpc.UnaryServerInterceptor(interceptorOpts...))
+		streamInterceptors = append(streamInterceptors, otelgrpc.StreamServerInterceptor(interceptorOpts...))
 	}
 
+	if s.createMeterProvider != nil {
+		mp, err := s.createMeterProvider(context.Background())
+		if err != nil {
+			s.log.WithError(err).Error("could not create meter provider")
+			return err
+		}
+
+		if mp != nil {
+			s.log.Info("meter provider connected")
+			s.meterProvider = mp
+			global.SetMeterProvider(mp)
+		}
+
+		unaryInterceptors = append(unaryInterceptors, s.otelMetrics.UnaryInterceptor())
+	}
+
+	if s.metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, s.metrics.UnaryInterceptor())
+	}
+
+	unaryInterceptors = append(unaryInterceptors, grpc2.LoggingUnaryInterceptor())
+
+	if s.serviceToken != "" {
+		unaryInterceptors = append(unaryInterceptors, grpc2.TokenUnaryInterceptor(s.serviceToken))
+		streamInterceptors = append(streamInterceptors, grpc2.TokenStreamInterceptor(s.serviceToken))
+	}
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+
 	s.grpcServer = grpc.NewServer(opts...)
 
 	// Load & Register the GRPC service plugins
@@ -202,33 +362,70 @@ func (s *Membrane) Start() error {
 	secretServer := s.createSecretServer()
 	v1.RegisterSecretServiceServer(s.grpcServer, secretServer)
 
-	resourceServer := grpc2.NewResourcesServiceServer(grpc2.WithResourcePlugin(s.resourcePlugin))
+	websocketServer := s.createWebsocketServer()
+	v1.RegisterWebsocketServiceServer(s.grpcServer, websocketServer)
+
+	resourceServer := grpc2.NewResourcesServiceServer(
+		grpc2.WithResourcePlugin(s.resourcePlugin),
+		grpc2.WithPermissionTable(s.permissions),
+		grpc2.WithApiDefinitions(s.apiDefinitions),
+	)
 	v1.RegisterResourceServiceServer(s.grpcServer, resourceServer)
 
 	// FaaS server MUST start before the child process
 	if s.mode == Mode_Faas {
-		faasServer := grpc2.NewFaasServer(s.pool)
+		faasOpts := []grpc2.FaasServerOption{grpc2.WithConcurrencyWait(s.concurrencyWait)}
+		if s.apiDefinitions != nil {
+			faasOpts = append(faasOpts, grpc2.WithRouteAuthorizer(security.NewJwtAuthorizer(s.apiDefinitions)))
+		}
+
+		if s.heartbeatInterval > 0 {
+			faasOpts = append(faasOpts, grpc2.WithHeartbeat(s.heartbeatInterval, s.heartbeatTimeout))
+
+			if s.restartUnresponsive {
+				faasOpts = append(faasOpts, grpc2.WithUnresponsiveHandler(s.restartUnresponsiveChild))
+			}
+		}
+
+		faasServer := grpc2.NewFaasServer(s.pool, faasOpts...)
 		v1.RegisterFaasServiceServer(s.grpcServer, faasServer)
 	}
-	lis, err := net.Listen("tcp", s.serviceAddress)
+	network, address := utils.SplitAddress(s.serviceAddress)
+	if network == "unix" {
+		// Clean up a socket left behind by a previous run
+		if err := os.Remove(address); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not remove existing service socket: %w", err)
+		}
+	}
+
+	lis, err := net.Listen(network, address)
 	if err != nil {
 		return fmt.Errorf("could not listen on configured service address: %w", err)
 	}
 
-	s.log("Registered Gateway Plugin")
+	s.log.Info("registered gateway plugin")
 
 	// Start the gRPC server
 	go (func() {
-		s.log(fmt.Sprintf("Services listening on: %s", s.serviceAddress))
+		s.log.WithField("address", s.serviceAddress).Info("services listening")
 		err := s.grpcServer.Serve(lis)
 		if err != nil {
-			s.log(fmt.Sprintf("grpc serve %v", err))
+			s.log.WithError(err).Error("could not serve services")
 		}
 	})()
 
+	adminErrchan := make(chan error, 1)
+	if s.adminServer != nil {
+		// Serve health checks while waiting on the child process, so orchestrators can tell it is still starting
+		go func(errch chan error) {
+			s.log.WithField("address", s.adminServer.Address()).Info("admin endpoints listening")
+			errch <- s.adminServer.Start()
+		}(adminErrchan)
+	}
+
 	// Start our child process
 	// This will block until our child process is ready to accept incoming connections
-	if err := s.processManager.StartUserProcess(); err != nil {
+	if err := s.processManager.StartUserProcesses(); err != nil {
 		return err
 	}
 
@@ -252,24 +449,35 @@ func (s *Membrane) Start() error {
 
 	// Wait for the minimum number of active workers to be available before beginning the gateway
 	// This ensures workers have registered and can handle triggers as soon the gateway is ready, if a minimum > 1 has been set
-	s.log("Waiting for active workers")
+	s.log.Info("waiting for active workers")
 	err = s.pool.WaitForMinimumWorkers(s.childTimeoutSeconds)
 	if err != nil {
 		return err
 	}
 
+	atomic.StoreInt32(&s.workersReady, 1)
+
+	if s.scaler != nil {
+		go s.scaler.run(s.scalerDone)
+	}
+
+	if s.scheduler != nil {
+		s.log.Info("starting scheduler")
+		go s.scheduler.Run(s.schedulerDone)
+	}
+
 	gatewayErrchan := make(chan error)
 	poolErrchan := make(chan error)
 
 	// Start the gateway
 	go func(errch chan error) {
-		s.log(fmt.Sprintf("Starting Gateway, %d workers currently available", s.pool.GetWorkerCount()))
+		s.log.WithField("workers", s.pool.GetWorkerCount()).Info("starting gateway")
 		errch <- s.gatewayPlugin.Start(s.pool)
 	}(gatewayErrchan)
 
 	// Start the worker pool monitor
 	go func(errch chan error) {
-		s.log("Starting Worker Supervisor")
+		s.log.Info("starting worker supervisor")
 		errch <- s.pool.Monitor()
 	}(poolErrchan)
 
@@ -293,18 +501,57 @@ func (s *Membrane) Start() error {
 		exitErr = fmt.Errorf(fmt.Sprintf("Supervisor error: %v, exiting", poolErr))
 	case processErr := <-processErrchan:
 		exitErr = fmt.Errorf(fmt.Sprintf("Process error: %v, exiting", processErr))
+	case adminErr := <-adminErrchan:
+		if adminErr == nil {
+			// Normal admin server shutdown, the membrane is stopping
+			return nil
+		}
+		exitErr = fmt.Errorf(fmt.Sprintf("Admin server error: %v, exiting", adminErr))
 	}
 
 	return exitErr
 }
 
+// Stop - stops accepting triggers, drains those in flight, then stops the child and pre-processes
 func (s *Membrane) Stop() {
-	if s.tracerProvider != nil {
-		_ = s.tracerProvider.Shutdown(context.Background())
+	if s.scaler != nil {
+		close(s.scalerDone)
+	}
+
+	if s.scheduler != nil {
+		close(s.schedulerDone)
 	}
+
 	_ = s.gatewayPlugin.Stop()
-	s.grpcServer.Stop()
+
+	// Workers stop receiving new triggers and are asked to shut down once their outstanding triggers are complete
+	if err := worker.DrainWorkers(s.pool, "membrane stopping"); err != nil {
+		s.log.WithError(err).Warn("could not notify workers of shutdown")
+	}
+
+	s.log.WithField("in_flight", s.inFlight.Count()).Info("draining in-flight triggers")
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	if err := s.inFlight.Wait(ctx); err != nil {
+		s.log.WithError(err).Warn("shutdown timeout reached before in-flight triggers completed")
+	}
+	cancel()
+
 	s.processManager.StopAll()
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	if s.adminServer != nil {
+		_ = s.adminServer.Stop()
+	}
+	if s.tracerProvider != nil {
+		_ = s.tracerProvider.Shutdown(context.Background())
+	}
+	if s.meterProvider != nil {
+		_ = s.meterProvider.Shutdown(context.Background())
+	}
+
+	s.log.Info("membrane stopped")
 }
 
 // Create a new Membrane server
@@ -314,6 +561,53 @@ func New(options *MembraneOptions) (*Membrane, error) {
 		options.ServiceAddress = utils.GetEnv("SERVICE_ADDRESS", "127.0.0.1:50051")
 	}
 
+	if options.LogLevel == "" {
+		options.LogLevel = utils.GetEnv("LOG_LEVEL", "info")
+	}
+
+	if options.LogFormat == "" {
+		options.LogFormat = logger.Format(utils.GetEnv("LOG_FORMAT", string(logger.FormatJSON)))
+	}
+
+	logOpts := &logger.Options{
+		Level:  options.LogLevel,
+		Format: options.LogFormat,
+	}
+
+	if options.SuppressLogs {
+		logOpts.Output = io.Discard
+	}
+
+	log, err := logger.New(logOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Plugins and gateways log through the default logger
+	logger.SetDefault(log)
+	log = log.WithField(logger.ComponentKey, "membrane")
+
+	if options.ServiceToken == "" {
+		options.ServiceToken = utils.GetEnv("SERVICE_TOKEN", "")
+	}
+
+	if options.ServiceTLSCertFile == "" {
+		options.ServiceTLSCertFile = utils.GetEnv("SERVICE_TLS_CERT", "")
+	}
+
+	if options.ServiceTLSKeyFile == "" {
+		options.ServiceTLSKeyFile = utils.GetEnv("SERVICE_TLS_KEY", "")
+	}
+
+	if options.ServiceTLSClientCAFile == "" {
+		options.ServiceTLSClientCAFile = utils.GetEnv("SERVICE_TLS_CLIENT_CA", "")
+	}
+
+	serviceTLSConfig, err := newServiceTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
 	if options.ChildAddress == "" {
 		options.ChildAddress = utils.GetEnv("CHILD_ADDRESS", "127.0.0.1:8080")
 	}
@@ -326,6 +620,22 @@ func New(options *MembraneOptions) (*Membrane, error) {
 		options.TolerateMissingServices = tolerateMissing
 	}
 
+	if !options.EnforcePolicies {
+		enforcePolicies, err := strconv.ParseBool(utils.GetEnv("ENFORCE_POLICIES", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.EnforcePolicies = enforcePolicies
+	}
+
+	if !options.EnforceApiSecurity {
+		enforceApiSecurity, err := strconv.ParseBool(utils.GetEnv("ENFORCE_API_SECURITY", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.EnforceApiSecurity = enforceApiSecurity
+	}
+
 	if options.Mode == nil {
 		mode, err := ModeFromString(utils.GetEnv("MEMBRANE_MODE", "FAAS"))
 		if err != nil {
@@ -338,16 +648,144 @@ func New(options *MembraneOptions) (*Membrane, error) {
 		options.ChildTimeoutSeconds = 10
 	}
 
+	if options.ChildProcesses == nil {
+		if processesEnv := utils.GetEnv("CHILD_PROCESSES", ""); processesEnv != "" {
+			if err := json.Unmarshal([]byte(processesEnv), &options.ChildProcesses); err != nil {
+				return nil, fmt.Errorf("invalid CHILD_PROCESSES env var, expected a JSON array of processes: %w", err)
+			}
+		}
+	}
+
+	if options.ChildRestartPolicy == "" {
+		restartPolicy, err := pm.RestartPolicyFromString(utils.GetEnv("CHILD_RESTART_POLICY", string(pm.RestartNever)))
+		if err != nil {
+			return nil, err
+		}
+		options.ChildRestartPolicy = restartPolicy
+	}
+
+	if options.ChildMaxRestarts < 1 {
+		maxRestartsEnv := utils.GetEnv("CHILD_MAX_RESTARTS", "5")
+		maxRestarts, err := strconv.Atoi(maxRestartsEnv)
+		if err != nil || maxRestarts < 1 {
+			return nil, fmt.Errorf("invalid CHILD_MAX_RESTARTS env var, expected positive integer value, got %v", maxRestartsEnv)
+		}
+		options.ChildMaxRestarts = maxRestarts
+	}
+
+	if options.ScaleThreshold < 1 {
+		scaleThresholdEnv := utils.GetEnv("SCALE_THRESHOLD", "0")
+		scaleThreshold, err := strconv.Atoi(scaleThresholdEnv)
+		if err != nil || scaleThreshold < 0 {
+			return nil, fmt.Errorf("invalid SCALE_THRESHOLD env var, expected non-negative integer value, got %v", scaleThresholdEnv)
+		}
+		options.ScaleThreshold = scaleThreshold
+	}
+
+	if options.ScaleIdleSeconds < 1 {
+		scaleIdleEnv := utils.GetEnv("SCALE_IDLE_SECONDS", "60")
+		scaleIdle, err := strconv.Atoi(scaleIdleEnv)
+		if err != nil || scaleIdle < 1 {
+			return nil, fmt.Errorf("invalid SCALE_IDLE_SECONDS env var, expected positive integer value, got %v", scaleIdleEnv)
+		}
+		options.ScaleIdleSeconds = scaleIdle
+	}
+
+	if options.ShutdownTimeoutSeconds < 1 {
+		shutdownTimeoutEnv := utils.GetEnv("SHUTDOWN_TIMEOUT_SECONDS", "10")
+		shutdownTimeout, err := strconv.Atoi(shutdownTimeoutEnv)
+		if err != nil || shutdownTimeout < 1 {
+			return nil, fmt.Errorf("invalid SHUTDOWN_TIMEOUT_SECONDS env var, expected positive integer value, got %v", shutdownTimeoutEnv)
+		}
+		options.ShutdownTimeoutSeconds = shutdownTimeout
+	}
+
+	if options.ConcurrencyWaitSeconds == 0 {
+		concurrencyWaitEnv := utils.GetEnv("CONCURRENCY_WAIT_SECONDS", "5")
+		concurrencyWait, err := strconv.Atoi(concurrencyWaitEnv)
+		if err != nil || concurrencyWait < 0 {
+			return nil, fmt.Errorf("invalid CONCURRENCY_WAIT_SECONDS env var, expected non-negative integer value, got %v", concurrencyWaitEnv)
+		}
+		options.ConcurrencyWaitSeconds = concurrencyWait
+	}
+
+	if options.HeartbeatIntervalSeconds == 0 {
+		heartbeatIntervalEnv := utils.GetEnv("HEARTBEAT_INTERVAL_SECONDS", "10")
+		heartbeatInterval, err := strconv.Atoi(heartbeatIntervalEnv)
+		if err != nil || heartbeatInterval < 0 {
+			return nil, fmt.Errorf("invalid HEARTBEAT_INTERVAL_SECONDS env var, expected non-negative integer value, got %v", heartbeatIntervalEnv)
+		}
+		options.HeartbeatIntervalSeconds = heartbeatInterval
+	}
+
+	if options.HeartbeatTimeoutSeconds < 1 {
+		heartbeatTimeoutEnv := utils.GetEnv("HEARTBEAT_TIMEOUT_SECONDS", "30")
+		heartbeatTimeout, err := strconv.Atoi(heartbeatTimeoutEnv)
+		if err != nil || heartbeatTimeout < 1 {
+			return nil, fmt.Errorf("invalid HEARTBEAT_TIMEOUT_SECONDS env var, expected positive integer value, got %v", heartbeatTimeoutEnv)
+		}
+		options.HeartbeatTimeoutSeconds = heartbeatTimeout
+	}
+
+	if !options.RestartUnresponsiveChild {
+		restartUnresponsive, err := strconv.ParseBool(utils.GetEnv("RESTART_UNRESPONSIVE_CHILD", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.RestartUnresponsiveChild = restartUnresponsive
+	}
+
+	if !options.RunSchedules {
+		runSchedules, err := strconv.ParseBool(utils.GetEnv("RUN_SCHEDULES", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.RunSchedules = runSchedules
+	}
+
+	if options.ScheduleJitterSeconds == 0 {
+		scheduleJitterEnv := utils.GetEnv("SCHEDULE_JITTER_SECONDS", "0")
+		scheduleJitter, err := strconv.Atoi(scheduleJitterEnv)
+		if err != nil || scheduleJitter < 0 {
+			return nil, fmt.Errorf("invalid SCHEDULE_JITTER_SECONDS env var, expected non-negative integer value, got %v", scheduleJitterEnv)
+		}
+		options.ScheduleJitterSeconds = scheduleJitter
+	}
+
+	if !options.ScheduleAllowOverlap {
+		scheduleAllowOverlap, err := strconv.ParseBool(utils.GetEnv("SCHEDULE_ALLOW_OVERLAP", "false"))
+		if err != nil {
+			return nil, err
+		}
+		options.ScheduleAllowOverlap = scheduleAllowOverlap
+	}
+
+	if options.ScheduleTimeoutSeconds == 0 {
+		scheduleTimeoutEnv := utils.GetEnv("SCHEDULE_TIMEOUT_SECONDS", "0")
+		scheduleTimeout, err := strconv.Atoi(scheduleTimeoutEnv)
+		if err != nil || scheduleTimeout < 0 {
+			return nil, fmt.Errorf("invalid SCHEDULE_TIMEOUT_SECONDS env var, expected non-negative integer value, got %v", scheduleTimeoutEnv)
+		}
+		options.ScheduleTimeoutSeconds = scheduleTimeout
+	}
+
 	if options.GatewayPlugin == nil {
 		return nil, errors.New("missing gateway plugin, Gateway plugin must not be nil")
 	}
 
 	if !options.TolerateMissingServices {
-		if options.EventsPlugin == nil || options.StoragePlugin == nil || options.DocumentPlugin == nil || options.QueuePlugin == nil {
+		if (options.EventsPlugin == nil && options.CreateEventsPlugin == nil) || options.StoragePlugin == nil || options.DocumentPlugin == nil || options.QueuePlugin == nil {
 			return nil, errors.New("missing membrane plugins, if you meant to load with missing plugins set options.TolerateMissingServices to true")
 		}
 	}
 
+	if options.AdminAddress == "" {
+		options.AdminAddress = utils.GetEnv("ADMIN_ADDRESS", "")
+	}
+
+	// The minimum is only known if the membrane creates the pool
+	poolMinWorkers := 0
+
 	if options.Pool == nil {
 		// Create new pool with defaults
 		minWorkersEnv := utils.GetEnv("MIN_WORKERS", "1")
@@ -362,41 +800,192 @@ func New(options *MembraneOptions) (*Membrane, error) {
 			return nil, fmt.Errorf("invalid MAX_WORKERS env var, expected non-negative integer value, got %v", maxWorkersEnv)
 		}
 
+		strategy, err := worker.StrategyFromString(utils.GetEnv("WORKER_STRATEGY", "first"))
+		if err != nil {
+			return nil, err
+		}
+
+		delivery, err := worker.DeliveryModeFromString(utils.GetEnv("WORKER_DELIVERY", string(worker.DeliveryAllOf)))
+		if err != nil {
+			return nil, err
+		}
+
+		recoveryEnv := utils.GetEnv("POOL_RECOVERY_SECONDS", "10")
+		recoverySeconds, err := strconv.Atoi(recoveryEnv)
+		if err != nil || recoverySeconds < 0 {
+			return nil, fmt.Errorf("invalid POOL_RECOVERY_SECONDS env var, expected non-negative integer value, got %v", recoveryEnv)
+		}
+
 		options.Pool = worker.NewProcessPool(&worker.ProcessPoolOptions{
 			MinWorkers: minWorkers,
 			MaxWorkers: maxWorkers,
+			Strategy:   strategy,
+			Delivery:   delivery,
+			Logger:     log,
+			// Workers may reconnect, e.g. when the child process is restarted
+			RecoveryWindow: time.Duration(recoverySeconds) * time.Second,
 		})
+		poolMinWorkers = minWorkers
+	}
+
+	// Scaling only adds replicas while their workers are within the pool's maximum
+	poolMaxWorkers := 0
+	if mp, ok := options.Pool.(interface{ GetMaxWorkers() int }); ok {
+		poolMaxWorkers = mp.GetMaxWorkers()
+	}
+
+	var promMetrics *metrics.PrometheusMetrics
+	if options.AdminAddress != "" {
+		promMetrics = metrics.NewPrometheusMetrics(options.Pool)
+
+		options.Pool = &worker.InstrumentedWorkerPool{
+			WorkerPool: options.Pool,
+			Wrapper:    promMetrics.WorkerFn,
+		}
 	}
 
 	bin := utils.GetEnv("OTELCOL_BIN", "/usr/bin/otelcol-contrib")
 	config := utils.GetEnv("OTELCOL_CONFIG", "/etc/otelcol/config.yaml")
 	createTracerProvider := options.CreateTracerProvider
+	createMeterProvider := options.CreateMeterProvider
+	otelcolAvailable := fileExists(bin) && fileExists(config)
+
+	traceExporter, err := tracing.ExporterFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if createTracerProvider == nil && traceExporter.Direct() {
+		// Direct export doesn't depend on the runtime, e.g. in minimal images and tests
+		createTracerProvider = tracing.NewTracerProvider
+	}
 
-	if createTracerProvider != nil && fileExists(bin) && fileExists(config) {
-		log.Default().Println("Tracing is enabled")
+	tracingEnabled := createTracerProvider != nil && (traceExporter.Direct() || (traceExporter == tracing.ExporterCollector && otelcolAvailable))
+	metricsEnabled := createMeterProvider != nil && otelcolAvailable
 
+	if (tracingEnabled && traceExporter == tracing.ExporterCollector) || metricsEnabled {
 		options.PreCommands = [][]string{
 			{
 				bin, "--config", config,
 			},
 		}
+	}
+
+	if tracingEnabled {
+		log.WithField("exporter", traceExporter).Info("tracing is enabled")
 
 		options.Pool = &worker.InstrumentedWorkerPool{
 			WorkerPool: options.Pool,
 			Wrapper:    worker.InstrumentedWorkerFn,
 		}
 	} else {
-		log.Default().Printf("Tracing is disabled %v %v %v", createTracerProvider != nil, fileExists(bin), fileExists(config))
+		log.WithFields(logger.Fields{
+			"exporter":       traceExporter,
+			"provider":       createTracerProvider != nil,
+			"otelcol_bin":    fileExists(bin),
+			"otelcol_config": fileExists(config),
+		}).Info("tracing is disabled")
 		createTracerProvider = nil
 	}
 
-	return &Membrane{
+	var otelMetrics *metrics.OtelMetrics
+	if metricsEnabled {
+		log.Info("metrics are enabled")
+
+		otelMetrics, err = metrics.NewOtelMetrics(options.Pool)
+		if err != nil {
+			return nil, err
+		}
+
+		options.Pool = &worker.InstrumentedWorkerPool{
+			WorkerPool: options.Pool,
+			Wrapper:    otelMetrics.WorkerFn,
+		}
+	} else {
+		log.WithFields(logger.Fields{
+			"provider":       createMeterProvider != nil,
+			"otelcol_bin":    fileExists(bin),
+			"otelcol_config": fileExists(config),
+		}).Info("metrics are disabled")
+		createMeterProvider = nil
+	}
+
+	// Let the child know where to find the membrane and where it should listen
+	childEnv := []string{
+		fmt.Sprintf("SERVICE_ADDRESS=%s", options.ServiceAddress),
+		fmt.Sprintf("CHILD_ADDRESS=%s", options.ChildAddress),
+	}
+
+	if options.ServiceToken != "" {
+		childEnv = append(childEnv, fmt.Sprintf("SERVICE_TOKEN=%s", options.ServiceToken))
+	}
+
+	shutdownTimeout := time.Duration(options.ShutdownTimeoutSeconds) * time.Second
+
+	processManager, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+		UserCommand:   options.ChildCommand,
+		UserProcesses: options.ChildProcesses,
+		UserEnv:       childEnv,
+		PreCommands:   options.PreCommands,
+		Logger:        log,
+		RestartPolicy: options.ChildRestartPolicy,
+		MaxRestarts:   options.ChildMaxRestarts,
+		StopTimeout:   shutdownTimeout,
+		ReadyTimeout:  time.Duration(options.ChildTimeoutSeconds) * time.Second,
+		WorkerCount:   options.Pool.GetWorkerCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inFlight := worker.NewInFlightTracker()
+	options.Pool = &worker.InstrumentedWorkerPool{
+		WorkerPool: options.Pool,
+		Wrapper:    inFlight.WorkerFn,
+	}
+
+	websocketPlugin := options.WebsocketPlugin
+	if websocketPlugin == nil {
+		// Gateways that accept websocket connections are also able to send to them
+		if ws, ok := options.GatewayPlugin.(websocket.WebsocketService); ok {
+			websocketPlugin = ws
+		}
+	}
+
+	if options.EventsPlugin == nil && options.CreateEventsPlugin != nil {
+		eventsPlugin, err := options.CreateEventsPlugin(options.Pool)
+		if err != nil {
+			return nil, fmt.Errorf("could not create events plugin: %w", err)
+		}
+		options.EventsPlugin = eventsPlugin
+	}
+
+	var permissions *grpc2.PermissionTable
+	if options.EnforcePolicies {
+		permissions = grpc2.NewPermissionTable()
+	}
+
+	var apiDefinitions *security.ApiDefinitions
+	if options.EnforceApiSecurity {
+		apiDefinitions = security.NewApiDefinitions()
+	}
+
+	m := &Membrane{
 		serviceAddress:          options.ServiceAddress,
+		serviceToken:            options.ServiceToken,
+		serviceTLSConfig:        serviceTLSConfig,
 		childAddress:            options.ChildAddress,
-		childUrl:                fmt.Sprintf("http://%s", options.ChildAddress),
-		processManager:          pm.NewProcessManager(options.ChildCommand, options.PreCommands),
+		processManager:          processManager,
 		createTracerProvider:    createTracerProvider,
+		createMeterProvider:     createMeterProvider,
+		otelMetrics:             otelMetrics,
 		childTimeoutSeconds:     options.ChildTimeoutSeconds,
+		shutdownTimeout:         shutdownTimeout,
+		concurrencyWait:         time.Duration(options.ConcurrencyWaitSeconds) * time.Second,
+		heartbeatInterval:       time.Duration(options.HeartbeatIntervalSeconds) * time.Second,
+		heartbeatTimeout:        time.Duration(options.HeartbeatTimeoutSeconds) * time.Second,
+		restartUnresponsive:     options.RestartUnresponsiveChild,
+		inFlight:                inFlight,
 		documentPlugin:          options.DocumentPlugin,
 		eventsPlugin:            options.EventsPlugin,
 		storagePlugin:           options.StoragePlugin,
@@ -404,9 +993,134 @@ func New(options *MembraneOptions) (*Membrane, error) {
 		gatewayPlugin:           options.GatewayPlugin,
 		secretPlugin:            options.SecretPlugin,
 		resourcePlugin:          options.ResourcesPlugin,
-		suppressLogs:            options.SuppressLogs,
+		websocketPlugin:         websocketPlugin,
+		log:                     log,
 		tolerateMissingServices: options.TolerateMissingServices,
+		permissions:             permissions,
+		apiDefinitions:          apiDefinitions,
 		mode:                    *options.Mode,
 		pool:                    options.Pool,
-	}, nil
+		minWorkers:              poolMinWorkers,
+		metrics:                 promMetrics,
+	}
+
+	if options.ScaleThreshold > 0 {
+		if *options.Mode != Mode_Faas || len(options.ChildCommand) == 0 {
+			return nil, fmt.Errorf("scaling requires FaaS mode and a child command")
+		}
+
+		m.scaler = &scaler{
+			replicas:    processManager,
+			inFlight:    inFlight.Count,
+			pool:        options.Pool,
+			maxWorkers:  poolMaxWorkers,
+			threshold:   options.ScaleThreshold,
+			idleTimeout: time.Duration(options.ScaleIdleSeconds) * time.Second,
+			log:         log.WithField(logger.ComponentKey, "scaler"),
+		}
+		m.scalerDone = make(chan struct{})
+	}
+
+	if options.RunSchedules {
+		if *options.Mode != Mode_Faas {
+			return nil, fmt.Errorf("running schedules requires FaaS mode")
+		}
+
+		m.scheduler = schedule.New(options.Pool, &schedule.Options{
+			Jitter:       time.Duration(options.ScheduleJitterSeconds) * time.Second,
+			AllowOverlap: options.ScheduleAllowOverlap,
+			Timeout:      time.Duration(options.ScheduleTimeoutSeconds) * time.Second,
+			Logger:       log,
+		})
+		m.schedulerDone = make(chan struct{})
+	}
+
+	if options.AdminAddress != "" {
+		m.adminServer = admin.New(options.AdminAddress, admin.WithMetrics(promMetrics.Handler()))
+		m.adminServer.AddReadinessCheck("process", m.checkUserProcess)
+		m.adminServer.AddReadinessCheck("workers", m.checkWorkers)
+	}
+
+	return m, nil
+}
+
+// checkUserProcess - readiness check failing if the child process isn't running
+func (s *Membrane) checkUserProcess() error {
+	if !s.processManager.UserProcessesRunning() {
+		return errors.New("user process is not running")
+	}
+
+	return nil
+}
+
+// checkWorkers - readiness check failing until the minimum number of workers have registered, or if the pool falls below it
+func (s *Membrane) checkWorkers() error {
+	if atomic.LoadInt32(&s.workersReady) == 0 {
+		return errors.New("waiting for the minimum number of workers to register")
+	}
+
+	if count := s.pool.GetWorkerCount(); count < s.minWorkers {
+		return fmt.Errorf("available workers below required minimum of %d, %d available", s.minWorkers, count)
+	}
+
+	return nil
+}
+
+// restartUnresponsiveChild - restarts the user processes after a worker stopped responding to heartbeats.
+// The other workers of the same process will also be unresponsive, so restarts within the heartbeat timeout of the last are skipped.
+func (s *Membrane) restartUnresponsiveChild(w worker.Worker) {
+	s.restartLock.Lock()
+	if time.Since(s.lastRestart) < s.heartbeatTimeout {
+		s.restartLock.Unlock()
+		return
+	}
+	s.lastRestart = time.Now()
+	s.restartLock.Unlock()
+
+	s.log.WithField(logger.WorkerTypeKey, worker.TypeOf(w)).Warn("restarting child process with unresponsive worker")
+
+	// Stopping the child may wait for the shutdown timeout, which must not hold up the worker's stream
+	go func() {
+		if err := s.processManager.RestartUserProcesses(); err != nil {
+			s.log.WithError(err).Error("could not restart child process")
+		}
+	}()
+}
+
+// newServiceTLSConfig - loads the TLS configuration for the service interfaces, returns nil if TLS is not configured
+func newServiceTLSConfig(options *MembraneOptions) (*tls.Config, error) {
+	if options.ServiceTLSCertFile == "" && options.ServiceTLSKeyFile == "" {
+		if options.ServiceTLSClientCAFile != "" {
+			return nil, errors.New("a service TLS certificate and key are required to verify client certificates")
+		}
+
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(options.ServiceTLSCertFile, options.ServiceTLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load service TLS certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if options.ServiceTLSClientCAFile != "" {
+		caPem, err := os.ReadFile(options.ServiceTLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read service TLS client CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, errors.New("service TLS client CA contains no valid certificates")
+		}
+
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
 }
@@ -181,6 +181,43 @@ var _ = Describe("Membrane", func() {
 				})
 			})
 		})
+
+		Context("Tracing", func() {
+			When("Spans are exported directly without the otelcol binary", func() {
+				mbraneOpts := membrane.MembraneOptions{
+					SuppressLogs:            true,
+					GatewayPlugin:           &MockGateway{},
+					TolerateMissingServices: true,
+					Pool:                    pool,
+				}
+
+				It("Should instrument the worker pool", func() {
+					os.Setenv("NITRIC_TRACE_EXPORTER", "console")
+					defer os.Unsetenv("NITRIC_TRACE_EXPORTER")
+
+					m, err := membrane.New(&mbraneOpts)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(m).ToNot(BeNil())
+					Expect(mbraneOpts.Pool).To(BeAssignableToTypeOf(&worker.InstrumentedWorkerPool{}))
+				})
+			})
+
+			When("The trace exporter is unknown", func() {
+				It("Should fail to create", func() {
+					os.Setenv("NITRIC_TRACE_EXPORTER", "jaeger")
+					defer os.Unsetenv("NITRIC_TRACE_EXPORTER")
+
+					m, err := membrane.New(&membrane.MembraneOptions{
+						SuppressLogs:            true,
+						GatewayPlugin:           &MockGateway{},
+						TolerateMissingServices: true,
+						Pool:                    pool,
+					})
+					Expect(err).Should(HaveOccurred())
+					Expect(m).To(BeNil())
+				})
+			})
+		})
 	})
 
 	Context("Starting the server", func() {
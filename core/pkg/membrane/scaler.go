@@ -0,0 +1,116 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nitrictech/nitric/core/pkg/pm"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+const scaleInterval = time.Second
+
+// scaler - adds replicas of the child process while triggers are queuing on the running processes, and removes them once idle
+type scaler struct {
+	replicas pm.ReplicaManager
+	// The number of triggers being handled
+	inFlight func() int64
+	pool     worker.WorkerPool
+	// Replicas are not added if their workers would exceed the maximum registered with the pool
+	maxWorkers int
+	// The in-flight triggers per process above which a replica is added
+	threshold int
+	// The time without in-flight triggers after which a replica is removed
+	idleTimeout time.Duration
+	log         *logrus.Entry
+
+	idleSince time.Time
+}
+
+// run - scales the replicas at each interval until done is closed
+func (s *scaler) run(done <-chan struct{}) {
+	ticker := time.NewTicker(scaleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			s.scale(now)
+		}
+	}
+}
+
+// scale - adds or removes a replica if required
+func (s *scaler) scale(now time.Time) {
+	replicas := s.replicas.Replicas()
+	if replicas == 0 {
+		return
+	}
+
+	inFlight := s.inFlight()
+
+	if inFlight > int64(s.threshold*replicas) {
+		s.idleSince = time.Time{}
+
+		workers := s.pool.GetWorkerCount()
+		// Each replica registers the same workers as the original process
+		workersPerReplica := workers / replicas
+		if workersPerReplica < 1 {
+			workersPerReplica = 1
+		}
+
+		if workers+workersPerReplica > s.maxWorkers {
+			s.log.WithField("workers", workers).Debug("not adding replica, maximum workers reached")
+			return
+		}
+
+		s.log.WithFields(logrus.Fields{
+			"in_flight": inFlight,
+			"replicas":  replicas,
+		}).Info("adding replica")
+
+		if err := s.replicas.AddReplica(); err != nil {
+			s.log.WithError(err).Warn("could not add replica")
+		}
+
+		return
+	}
+
+	if inFlight > 0 || replicas == 1 {
+		s.idleSince = time.Time{}
+		return
+	}
+
+	if s.idleSince.IsZero() {
+		s.idleSince = now
+		return
+	}
+
+	if now.Sub(s.idleSince) >= s.idleTimeout {
+		s.log.WithField("replicas", replicas).Info("removing idle replica")
+
+		if err := s.replicas.RemoveReplica(); err != nil {
+			s.log.WithError(err).Warn("could not remove replica")
+		}
+
+		// Each further replica is removed after another idle period
+		s.idleSince = now
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membrane
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+type fakeReplicas struct {
+	replicas int
+}
+
+func (f *fakeReplicas) AddReplica() error {
+	f.replicas++
+	return nil
+}
+
+func (f *fakeReplicas) RemoveReplica() error {
+	f.replicas--
+	return nil
+}
+
+func (f *fakeReplicas) Replicas() int {
+	return f.replicas
+}
+
+var _ = Describe("scaler", func() {
+	var replicas *fakeReplicas
+	var inFlight int64
+	var s *scaler
+
+	BeforeEach(func() {
+		replicas = &fakeReplicas{replicas: 1}
+		inFlight = 0
+
+		pool := worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 2})
+		Expect(pool.AddWorker(worker.NewSubscriptionWorker(nil, &worker.SubscriptionWorkerOptions{Topic: "test"}))).To(Succeed())
+
+		s = &scaler{
+			replicas:    replicas,
+			inFlight:    func() int64 { return inFlight },
+			pool:        pool,
+			maxWorkers:  2,
+			threshold:   1,
+			idleTimeout: time.Minute,
+			log:         logger.Default(),
+		}
+	})
+
+	When("in-flight triggers per process exceed the threshold", func() {
+		It("should add a replica up to the maximum workers", func() {
+			inFlight = 2
+			s.scale(time.Now())
+			Expect(replicas.replicas).To(Equal(2))
+
+			// The replica registers the same workers as the original, another would exceed the maximum
+			Expect(s.pool.AddWorker(worker.NewSubscriptionWorker(nil, &worker.SubscriptionWorkerOptions{Topic: "test"}))).To(Succeed())
+			inFlight = 10
+			s.scale(time.Now())
+			Expect(replicas.replicas).To(Equal(2))
+		})
+	})
+
+	When("there are no in-flight triggers", func() {
+		It("should remove a replica after the idle timeout", func() {
+			replicas.replicas = 3
+			now := time.Now()
+
+			s.scale(now)
+			Expect(replicas.replicas).To(Equal(3))
+
+			s.scale(now.Add(30 * time.Second))
+			Expect(replicas.replicas).To(Equal(3))
+
+			s.scale(now.Add(time.Minute))
+			Expect(replicas.replicas).To(Equal(2))
+
+			By("waiting another idle period before removing the next")
+			s.scale(now.Add(90 * time.Second))
+			Expect(replicas.replicas).To(Equal(2))
+
+			s.scale(now.Add(2 * time.Minute))
+			Expect(replicas.replicas).To(Equal(1))
+
+			By("never removing the original process")
+			s.scale(now.Add(time.Hour))
+			Expect(replicas.replicas).To(Equal(1))
+		})
+
+		It("should reset the idle period when triggers arrive", func() {
+			replicas.replicas = 2
+			now := time.Now()
+
+			s.scale(now)
+
+			inFlight = 1
+			s.scale(now.Add(30 * time.Second))
+
+			inFlight = 0
+			s.scale(now.Add(time.Minute))
+			Expect(replicas.replicas).To(Equal(2))
+		})
+	})
+})
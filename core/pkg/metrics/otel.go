@@ -0,0 +1,222 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/plugins/errors"
+	"github.com/nitrictech/nitric/core/pkg/plugins/errors/codes"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+const instrumentationName = "github.com/nitrictech/nitric/core/pkg/metrics"
+
+// OtelMetrics - Records membrane metrics with the global OpenTelemetry meter provider
+type OtelMetrics struct {
+	workerDuration syncfloat64.Histogram
+	workerInFlight syncint64.UpDownCounter
+	pluginDuration syncfloat64.Histogram
+}
+
+// WorkerFn - wraps workers to record the duration, status and in-flight count of the triggers they handle
+func (m *OtelMetrics) WorkerFn(w worker.Worker) worker.Worker {
+	return &otelWorker{
+		Worker:  w,
+		metrics: m,
+	}
+}
+
+// UnaryInterceptor - records the duration and error code of plugin calls
+func (m *OtelMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		service, method := splitFullMethod(info.FullMethod)
+		m.pluginDuration.Record(ctx, time.Since(start).Seconds(),
+			attribute.String("service", service),
+			attribute.String("method", method),
+			attribute.String("code", errorCode(err).String()),
+		)
+
+		return resp, err
+	}
+}
+
+// errorCode - the nitric error code of a plugin call, plugin errors are converted to gRPC status errors by the servers
+func errorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	var pe *errors.PluginError
+	if errors.As(err, &pe) {
+		return errors.Code(err)
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return codes.Code(s.Code())
+	}
+
+	return codes.Unknown
+}
+
+type otelWorker struct {
+	worker.Worker
+	metrics *OtelMetrics
+}
+
+// Unwrap - returns the measured worker
+func (w *otelWorker) Unwrap() worker.Worker {
+	return w.Worker
+}
+
+// record - tracks the trigger as in-flight until the returned function is called with its result
+func (w *otelWorker) record(ctx context.Context, trigger string) func(result string) {
+	typ := attribute.String("worker", WorkerType(w.Worker))
+	trig := attribute.String("trigger", trigger)
+
+	start := time.Now()
+	w.metrics.workerInFlight.Add(ctx, 1, typ, trig)
+
+	return func(result string) {
+		w.metrics.workerInFlight.Add(ctx, -1, typ, trig)
+		w.metrics.workerDuration.Record(ctx, time.Since(start).Seconds(), typ, trig, attribute.String("status", result))
+	}
+}
+
+// outcome - the status of a trigger that doesn't produce a response
+func outcome(err error) string {
+	if err != nil {
+		return "failure"
+	}
+
+	return "success"
+}
+
+func (w *otelWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	done := w.record(ctx, "http")
+	resp, err := w.Worker.HandleHttpRequest(ctx, trigger)
+
+	code := "error"
+	if err == nil && resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	done(code)
+
+	return resp, err
+}
+
+func (w *otelWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	done := w.record(ctx, "event")
+	err := w.Worker.HandleEvent(ctx, trigger)
+	done(outcome(err))
+
+	return err
+}
+
+func (w *otelWorker) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	wh, ok := w.Worker.(worker.WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle websocket messages")
+	}
+
+	done := w.record(ctx, "websocket")
+	err := wh.HandleWebsocketMessage(ctx, trigger)
+	done(outcome(err))
+
+	return err
+}
+
+func (w *otelWorker) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	sh, ok := w.Worker.(worker.ScheduleHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle schedules")
+	}
+
+	done := w.record(ctx, "schedule")
+	err := sh.HandleSchedule(ctx, trigger)
+	done(outcome(err))
+
+	return err
+}
+
+// NewOtelMetrics - creates instruments reporting on the triggers handled by workers of the given pool.
+// Instruments are created with the global meter provider, measurements are dropped until one is set.
+func NewOtelMetrics(pool worker.WorkerPool) (*OtelMetrics, error) {
+	meter := global.Meter(instrumentationName)
+
+	workerDuration, err := meter.SyncFloat64().Histogram("nitric.worker.duration",
+		instrument.WithUnit(unit.Unit("s")),
+		instrument.WithDescription("Duration of triggers handled by workers, by worker type, trigger and status."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workerInFlight, err := meter.SyncInt64().UpDownCounter("nitric.worker.in_flight",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of triggers currently being handled by workers, by worker type and trigger."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginDuration, err := meter.SyncFloat64().Histogram("nitric.plugin.duration",
+		instrument.WithUnit(unit.Unit("s")),
+		instrument.WithDescription("Duration of calls to the membrane plugin services, by service, method and error code."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workers, err := meter.AsyncInt64().Gauge("nitric.workers",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of workers registered with the pool, by type."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = meter.RegisterCallback([]instrument.Asynchronous{workers}, func(ctx context.Context) {
+		for typ, count := range WorkerCounts(pool) {
+			workers.Observe(ctx, int64(count), attribute.String("type", typ))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OtelMetrics{
+		workerDuration: workerDuration,
+		workerInFlight: workerInFlight,
+		pluginDuration: pluginDuration,
+	}, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mock_worker "github.com/nitrictech/nitric/core/mocks/worker"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// collect - returns the named metric from the reader, nil if it hasn't been recorded
+func collect(reader sdkmetric.Reader, name string) *metricdata.Metrics {
+	rm, err := reader.Collect(context.TODO())
+	Expect(err).ShouldNot(HaveOccurred())
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return &m
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasAttributes(set attribute.Set, kvs ...attribute.KeyValue) bool {
+	for _, kv := range kvs {
+		if v, ok := set.Value(kv.Key); !ok || v != kv.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+var _ = Describe("OtelMetrics", func() {
+	reader := sdkmetric.NewManualReader()
+	global.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	ctrl := gomock.NewController(GinkgoT())
+	adapter := mock_worker.NewMockAdapter(ctrl)
+
+	pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+	m, err := metrics.NewOtelMetrics(pool)
+
+	It("should create the instruments", func() {
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Workers", func() {
+		When("a subscription worker fails to handle an event", func() {
+			sw := m.WorkerFn(worker.NewSubscriptionWorker(adapter, &worker.SubscriptionWorkerOptions{
+				Topic: "orders",
+			}))
+			_ = pool.AddWorker(sw)
+
+			It("should record the duration with the failure status", func() {
+				adapter.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+
+				err := sw.HandleEvent(context.TODO(), &triggers.Event{Topic: "orders"})
+				Expect(err).Should(HaveOccurred())
+
+				duration := collect(reader, "nitric.worker.duration")
+				Expect(duration).ToNot(BeNil())
+
+				hist, ok := duration.Data.(metricdata.Histogram)
+				Expect(ok).To(BeTrue())
+				Expect(hist.DataPoints).To(HaveLen(1))
+				Expect(hist.DataPoints[0].Count).To(Equal(uint64(1)))
+				Expect(hasAttributes(hist.DataPoints[0].Attributes,
+					attribute.String("worker", "subscription"),
+					attribute.String("trigger", "event"),
+					attribute.String("status", "failure"),
+				)).To(BeTrue())
+
+				By("no longer counting the event as in-flight")
+				inFlight := collect(reader, "nitric.worker.in_flight")
+				Expect(inFlight).ToNot(BeNil())
+
+				sum, ok := inFlight.Data.(metricdata.Sum[int64])
+				Expect(ok).To(BeTrue())
+				Expect(sum.DataPoints).To(HaveLen(1))
+				Expect(sum.DataPoints[0].Value).To(Equal(int64(0)))
+
+				By("reporting the registered workers by type")
+				workers := collect(reader, "nitric.workers")
+				Expect(workers).ToNot(BeNil())
+
+				gauge, ok := workers.Data.(metricdata.Gauge[int64])
+				Expect(ok).To(BeTrue())
+				Expect(gauge.DataPoints).To(HaveLen(1))
+				Expect(gauge.DataPoints[0].Value).To(Equal(int64(1)))
+				Expect(hasAttributes(gauge.DataPoints[0].Attributes, attribute.String("type", "subscription"))).To(BeTrue())
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Plugin calls", func() {
+		When("a plugin call returns an error", func() {
+			It("should record the call by service, method and nitric error code", func() {
+				_, err := m.UnaryInterceptor()(context.TODO(), nil, &grpc.UnaryServerInfo{
+					FullMethod: "/nitric.secret.v1.SecretService/Access",
+				}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, status.Error(codes.NotFound, "not found")
+				})
+				Expect(err).Should(HaveOccurred())
+
+				duration := collect(reader, "nitric.plugin.duration")
+				Expect(duration).ToNot(BeNil())
+
+				hist, ok := duration.Data.(metricdata.Histogram)
+				Expect(ok).To(BeTrue())
+				Expect(hist.DataPoints).To(HaveLen(1))
+				Expect(hasAttributes(hist.DataPoints[0].Attributes,
+					attribute.String("service", "SecretService"),
+					attribute.String("method", "Access"),
+					attribute.String("code", "Not Found"),
+				)).To(BeTrue())
+			})
+		})
+	})
+})
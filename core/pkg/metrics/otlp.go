@@ -0,0 +1,31 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// DefaultOTLPEndpoint - the gRPC receiver of the otelcol pre-process
+const DefaultOTLPEndpoint = "localhost:4317"
+
+// NewOTLPExporter - creates an exporter sending metrics over gRPC to the collector at the given address, e.g. the otelcol pre-process.
+// The connection is established lazily so the collector may start after the exporter is created.
+func NewOTLPExporter(ctx context.Context, endpoint string) (sdkmetric.Exporter, error) {
+	return otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithEndpoint(endpoint), otlpmetricgrpc.WithInsecure())
+}
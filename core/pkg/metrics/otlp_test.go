@@ -0,0 +1,68 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/grpc"
+
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+)
+
+type mockCollector struct {
+	collectorpb.UnimplementedMetricsServiceServer
+	requests chan *collectorpb.ExportMetricsServiceRequest
+}
+
+func (c *mockCollector) Export(ctx context.Context, req *collectorpb.ExportMetricsServiceRequest) (*collectorpb.ExportMetricsServiceResponse, error) {
+	c.requests <- req
+	return &collectorpb.ExportMetricsServiceResponse{}, nil
+}
+
+var _ = Describe("OTLPExporter", func() {
+	When("exporting metrics", func() {
+		lis, _ := net.Listen("tcp", "127.0.0.1:0")
+		collector := &mockCollector{requests: make(chan *collectorpb.ExportMetricsServiceRequest, 1)}
+
+		srv := grpc.NewServer()
+		collectorpb.RegisterMetricsServiceServer(srv, collector)
+		go func() { _ = srv.Serve(lis) }()
+
+		exp, err := metrics.NewOTLPExporter(context.TODO(), lis.Addr().String())
+
+		It("should send them to the collector at the endpoint", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			defer srv.Stop()
+
+			Expect(exp.Export(context.TODO(), metricdata.ResourceMetrics{
+				Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+			})).To(Succeed())
+
+			var req *collectorpb.ExportMetricsServiceRequest
+			Eventually(collector.requests).Should(Receive(&req))
+			Expect(req.ResourceMetrics).To(HaveLen(1))
+
+			Expect(exp.Shutdown(context.TODO())).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,211 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// PrometheusMetrics - Records membrane metrics for scraping by Prometheus
+type PrometheusMetrics struct {
+	registry *prometheus.Registry
+
+	httpRequests   *prometheus.CounterVec
+	httpDuration   *prometheus.HistogramVec
+	events         *prometheus.CounterVec
+	eventDuration  *prometheus.HistogramVec
+	pluginDuration *prometheus.HistogramVec
+}
+
+// Handler - serves the recorded metrics in the Prometheus exposition format
+func (m *PrometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// WorkerFn - wraps workers to record the outcomes and latencies of the triggers they handle
+func (m *PrometheusMetrics) WorkerFn(w worker.Worker) worker.Worker {
+	return &metricsWorker{
+		Worker:  w,
+		metrics: m,
+	}
+}
+
+// UnaryInterceptor - records the latency and status code of plugin calls
+func (m *PrometheusMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		service, method := splitFullMethod(info.FullMethod)
+		m.pluginDuration.WithLabelValues(service, method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+// splitFullMethod - splits a gRPC method name, e.g. /nitric.secret.v1.SecretService/Put -> SecretService, Put
+func splitFullMethod(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+
+	return service, method
+}
+
+// workerCollector - reports the number of workers registered with the pool by type
+type workerCollector struct {
+	pool worker.WorkerPool
+	desc *prometheus.Desc
+}
+
+func (c *workerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *workerCollector) Collect(ch chan<- prometheus.Metric) {
+	for typ, count := range WorkerCounts(c.pool) {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), typ)
+	}
+}
+
+type metricsWorker struct {
+	worker.Worker
+	metrics *PrometheusMetrics
+}
+
+// Unwrap - returns the measured worker
+func (w *metricsWorker) Unwrap() worker.Worker {
+	return w.Worker
+}
+
+// route - the route label for requests handled by the worker, catch all workers share a single label to bound cardinality
+func (w *metricsWorker) route() (string, string) {
+	if rw, ok := worker.BaseWorker(w.Worker).(*worker.RouteWorker); ok {
+		return rw.Api(), rw.Path()
+	}
+
+	return "", "*"
+}
+
+func (w *metricsWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	start := time.Now()
+	resp, err := w.Worker.HandleHttpRequest(ctx, trigger)
+
+	code := "error"
+	if err == nil && resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	api, route := w.route()
+	w.metrics.httpRequests.WithLabelValues(api, route, trigger.Method, code).Inc()
+	w.metrics.httpDuration.WithLabelValues(api, route, trigger.Method).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+func (w *metricsWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	start := time.Now()
+	err := w.Worker.HandleEvent(ctx, trigger)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+
+	w.metrics.events.WithLabelValues(trigger.Topic, outcome).Inc()
+	w.metrics.eventDuration.WithLabelValues(trigger.Topic).Observe(time.Since(start).Seconds())
+
+	return err
+}
+
+func (w *metricsWorker) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	wh, ok := w.Worker.(worker.WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle websocket messages")
+	}
+
+	return wh.HandleWebsocketMessage(ctx, trigger)
+}
+
+func (w *metricsWorker) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	sh, ok := w.Worker.(worker.ScheduleHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle schedules")
+	}
+
+	return sh.HandleSchedule(ctx, trigger)
+}
+
+// NewPrometheusMetrics - creates a metrics registry reporting on the triggers handled by workers of the given pool
+func NewPrometheusMetrics(pool worker.WorkerPool) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "nitric_http_requests_total",
+			Help: "Count of http requests handled by workers, by route and response status.",
+		}, []string{"api", "route", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "nitric_http_request_duration_seconds",
+			Help:    "Latency of http requests handled by workers, by route.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"api", "route", "method"}),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "nitric_events_total",
+			Help: "Count of events handled by workers, by topic and outcome.",
+		}, []string{"topic", "outcome"}),
+		eventDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "nitric_event_duration_seconds",
+			Help:    "Latency of events handled by workers, by topic.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"topic"}),
+		pluginDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "nitric_plugin_call_duration_seconds",
+			Help:    "Latency of calls to the membrane plugin services, by service, method and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"service", "method", "code"}),
+	}
+
+	m.registry.MustRegister(
+		m.httpRequests,
+		m.httpDuration,
+		m.events,
+		m.eventDuration,
+		m.pluginDuration,
+		&workerCollector{
+			pool: pool,
+			desc: prometheus.NewDesc("nitric_workers", "Number of workers registered with the pool, by type.", []string{"type"}, nil),
+		},
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mock_worker "github.com/nitrictech/nitric/core/mocks/worker"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+func scrape(m *metrics.PrometheusMetrics) string {
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, _ := io.ReadAll(rec.Result().Body)
+
+	return string(body)
+}
+
+var _ = Describe("PrometheusMetrics", func() {
+	Context("Http requests", func() {
+		When("a route worker handles a request", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			adapter := mock_worker.NewMockAdapter(ctrl)
+
+			pool := worker.NewProcessPool(&worker.ProcessPoolOptions{})
+			m := metrics.NewPrometheusMetrics(pool)
+
+			rw := m.WorkerFn(worker.NewRouteWorker(adapter, &worker.RouteWorkerOptions{
+				Api:     "main",
+				Path:    "/customers/:id",
+				Methods: []string{"GET"},
+			}))
+			_ = pool.AddWorker(rw)
+
+			It("should count the request by route and status", func() {
+				adapter.EXPECT().HandleHttpRequest(gomock.Any(), gomock.Any()).Return(&triggers.HttpResponse{
+					StatusCode: 200,
+				}, nil)
+
+				_, err := rw.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{
+					Method: "GET",
+					Path:   "/customers/1",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				out := scrape(m)
+				Expect(out).To(ContainSubstring(`nitric_http_requests_total{api="main",method="GET",route="/customers/:id",status="200"} 1`))
+				Expect(out).To(ContainSubstring(`nitric_http_request_duration_seconds_count{api="main",method="GET",route="/customers/:id"} 1`))
+
+				By("reporting the registered workers by type")
+				Expect(out).To(ContainSubstring(`nitric_workers{type="route"} 1`))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Events", func() {
+		When("a subscription worker fails to handle an event", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			adapter := mock_worker.NewMockAdapter(ctrl)
+
+			m := metrics.NewPrometheusMetrics(worker.NewProcessPool(&worker.ProcessPoolOptions{}))
+			sw := m.WorkerFn(worker.NewSubscriptionWorker(adapter, &worker.SubscriptionWorkerOptions{
+				Topic: "orders",
+			}))
+
+			It("should count the failure by topic", func() {
+				adapter.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+
+				err := sw.HandleEvent(context.TODO(), &triggers.Event{Topic: "orders"})
+				Expect(err).Should(HaveOccurred())
+
+				Expect(scrape(m)).To(ContainSubstring(`nitric_events_total{outcome="failure",topic="orders"} 1`))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Plugin calls", func() {
+		When("a plugin call returns an error", func() {
+			m := metrics.NewPrometheusMetrics(worker.NewProcessPool(&worker.ProcessPoolOptions{}))
+
+			It("should record the call by service, method and code", func() {
+				_, err := m.UnaryInterceptor()(context.TODO(), nil, &grpc.UnaryServerInfo{
+					FullMethod: "/nitric.secret.v1.SecretService/Access",
+				}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, status.Error(codes.NotFound, "not found")
+				})
+				Expect(err).Should(HaveOccurred())
+
+				Expect(scrape(m)).To(ContainSubstring(`nitric_plugin_call_duration_seconds_count{code="NotFound",method="Access",service="SecretService"} 1`))
+			})
+		})
+	})
+})
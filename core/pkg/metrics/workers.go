@@ -0,0 +1,35 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// WorkerType - a short name for the type of trigger the worker was registered to handle
+func WorkerType(w worker.Worker) string {
+	return worker.TypeOf(w)
+}
+
+// WorkerCounts - the number of workers registered with the pool, by type
+func WorkerCounts(pool worker.WorkerPool) map[string]int {
+	counts := map[string]int{}
+
+	for _, w := range pool.GetWorkers(&worker.GetWorkerOptions{}) {
+		counts[WorkerType(w)]++
+	}
+
+	return counts
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+)
+
+// WebsocketService - Sends messages to and manages the connections of websocket clients
+type WebsocketService interface {
+	// Send - Sends data to the connection with the given ID on the named socket
+	Send(ctx context.Context, socket string, connectionId string, data []byte) error
+	// Close - Closes the connection with the given ID on the named socket
+	Close(ctx context.Context, socket string, connectionId string) error
+}
+
+type UnimplementedWebsocketPlugin struct {
+	WebsocketService
+}
+
+func (*UnimplementedWebsocketPlugin) Send(ctx context.Context, socket string, connectionId string, data []byte) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
+
+func (*UnimplementedWebsocketPlugin) Close(ctx context.Context, socket string, connectionId string) error {
+	return fmt.Errorf("UNIMPLEMENTED")
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// outputLock - serialises writes from the processes sharing the membrane's output, so their lines are not interleaved
+var outputLock sync.Mutex
+
+// maxLineLength - the length after which a line without a newline is written as a line of its own,
+// so a process writing without newlines can't grow the buffer without bound
+const maxLineLength = 64 * 1024
+
+// prefixWriter - writes complete lines prefixed with the name of the process that wrote them
+type prefixWriter struct {
+	out    io.Writer
+	prefix []byte
+	lock   sync.Mutex
+	buf    []byte
+}
+
+func newPrefixWriter(out io.Writer, name string) *prefixWriter {
+	var prefix []byte
+	if name != "" {
+		prefix = []byte("[" + name + "] ")
+	}
+
+	return &prefixWriter{
+		out:    out,
+		prefix: prefix,
+	}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	// Output of unnamed processes is written unchanged
+	if len(w.prefix) == 0 {
+		return w.out.Write(p)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		if err := w.writeLine(w.buf[:i+1]); err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[i+1:]
+	}
+
+	for len(w.buf) >= maxLineLength {
+		if err := w.writeLine(append(w.buf[:maxLineLength:maxLineLength], '\n')); err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[maxLineLength:]
+	}
+
+	return len(p), nil
+}
+
+// Flush - writes any incomplete final line
+func (w *prefixWriter) Flush() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.buf) > 0 {
+		_ = w.writeLine(append(w.buf, '\n'))
+		w.buf = nil
+	}
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	_, err := w.out.Write(append(append([]byte{}, w.prefix...), line...))
+
+	return err
+}
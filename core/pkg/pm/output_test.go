@@ -0,0 +1,73 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("prefixWriter", func() {
+	When("the process is named", func() {
+		It("should prefix each complete line", func() {
+			out := &bytes.Buffer{}
+			w := newPrefixWriter(out, "web")
+
+			_, err := w.Write([]byte("hello\nwor"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.String()).To(Equal("[web] hello\n"))
+
+			_, err = w.Write([]byte("ld\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.String()).To(Equal("[web] hello\n[web] world\n"))
+		})
+
+		It("should write an incomplete final line when flushed", func() {
+			out := &bytes.Buffer{}
+			w := newPrefixWriter(out, "web")
+
+			_, _ = w.Write([]byte("partial"))
+			w.Flush()
+
+			Expect(out.String()).To(Equal("[web] partial\n"))
+		})
+
+		It("should write lines longer than the maximum length as they are received", func() {
+			out := &bytes.Buffer{}
+			w := newPrefixWriter(out, "web")
+
+			long := bytes.Repeat([]byte("a"), maxLineLength)
+			_, err := w.Write(append(long, "bc"...))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.String()).To(Equal("[web] " + string(long) + "\n"))
+
+			w.Flush()
+			Expect(out.String()).To(HaveSuffix("\n[web] bc\n"))
+		})
+	})
+
+	When("the process is unnamed", func() {
+		It("should write output unchanged", func() {
+			out := &bytes.Buffer{}
+			w := newPrefixWriter(out, "")
+
+			_, _ = w.Write([]byte("partial"))
+
+			Expect(out.String()).To(Equal("partial"))
+		})
+	})
+})
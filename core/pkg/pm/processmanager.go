@@ -15,54 +15,305 @@
 package pm
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"os"
 	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// RestartPolicy - governs whether processes are restarted when they exit
+type RestartPolicy string
+
+const (
+	// RestartNever - the first process to exit stops the membrane
+	RestartNever RestartPolicy = "never"
+	// RestartOnFailure - processes exiting with an error are restarted
+	RestartOnFailure RestartPolicy = "on-failure"
+	// RestartAlways - processes are restarted whenever they exit
+	RestartAlways RestartPolicy = "always"
 )
 
+// RestartPolicyFromString - returns the restart policy with the given name
+func RestartPolicyFromString(policy string) (RestartPolicy, error) {
+	switch p := RestartPolicy(strings.ToLower(policy)); p {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown restart policy %s, expected %s, %s or %s", policy, RestartNever, RestartOnFailure, RestartAlways)
+	}
+}
+
+const (
+	defaultMaxRestarts    = 5
+	defaultRestartBackoff = time.Second
+	maxRestartBackoff     = 30 * time.Second
+	defaultStopTimeout    = 10 * time.Second
+	defaultReadyTimeout   = 10 * time.Second
+)
+
+// ProcessOptions - a user process started by the process manager
+type ProcessOptions struct {
+	// Identifies the process in logs and prefixes each line of its output, output is not prefixed if empty
+	Name string `json:"name"`
+	// The command that will be used to invoke the process
+	Command []string `json:"command"`
+	// Additional environment variables in key=value form
+	Env []string `json:"env"`
+	// The working directory of the process, defaults to the membrane's
+	Dir string `json:"dir"`
+	// The condition for the process to be ready, see ParseReadiness, the process is ready once started if empty
+	Ready string `json:"ready"`
+}
+
 type process struct {
+	Name    string
 	Command []string
+	// Additional environment variables in key=value form
+	Env    []string
+	Dir    string
+	ready  *Readiness
+	stdout *prefixWriter
+	stderr *prefixWriter
+	log    *logrus.Entry
+	// One of the process states, read concurrently by health checks
+	state int32
+	// Guards the current run of the process, which is replaced when it restarts while it may be being stopped
+	runLock sync.Mutex
 	cmd     *exec.Cmd
+	// Closed once the current run of the process has exited, with its exit error in exitErr
+	exited  chan struct{}
+	exitErr error
+	// Set when a replica is removed, so its exit is expected
+	removed bool
+	// Set when the process is being restarted, so it is started again after it exits
+	restarting bool
 }
 
+const (
+	processNotStarted int32 = iota
+	processRunning
+	processExited
+)
+
 type pMgr struct {
 	preProcesses   []*process
-	userProcess    *process
+	userProcesses  []*process
 	monitorErrChan chan error
+	log            *logrus.Entry
+
+	readyTimeout time.Duration
+	workerCount  func() int
+
+	// The process running the user command, which replicas are copies of, nil if there is no user command
+	userCommand *process
+	replicas    []*process
+	// The number of replicas ever added, used to name them
+	replicaCount int
+
+	restartPolicy  RestartPolicy
+	maxRestarts    int
+	restartBackoff time.Duration
+	stopTimeout    time.Duration
+
+	// Guards starting processes against StopAll, so stopped processes are not restarted
+	lock       sync.Mutex
+	stopping   bool
+	monitoring bool
 }
 
 type ProcessManager interface {
+	ReplicaManager
 	StartPreProcesses() error
-	StartUserProcess() error
+	// StartUserProcesses - starts the user processes in order, waiting for each to be ready before starting the next
+	StartUserProcesses() error
 	Monitor() error
 	StopAll()
+	// UserProcessesRunning - returns true if the user processes have started and not yet exited
+	UserProcessesRunning() bool
+	// RestartUserProcesses - stops the user processes and their replicas, which are then restarted regardless of the restart policy
+	RestartUserProcesses() error
+}
+
+type ProcessManagerOptions struct {
+	// The command that will be used to invoke an unnamed user process, started before UserProcesses
+	UserCommand []string
+	// Additional user processes
+	UserProcesses []ProcessOptions
+	// Environment variables added to every user process, in key=value form
+	UserEnv []string
+	// Commands that will be started before the user process
+	PreCommands [][]string
+	// Defaults to the default logger
+	Logger *logrus.Entry
+	// Whether processes are restarted when they exit, defaults to never
+	RestartPolicy RestartPolicy
+	// The number of times a process may be restarted before its exit stops the membrane, defaults to 5
+	MaxRestarts int
+	// The delay before the first restart of a process, doubling with each consecutive restart up to 30 seconds, defaults to 1 second
+	RestartBackoff time.Duration
+	// The time to wait for processes to exit after SIGTERM before they are killed, defaults to 10 seconds
+	StopTimeout time.Duration
+	// The time to wait for each user process to be ready, defaults to 10 seconds
+	ReadyTimeout time.Duration
+	// The number of workers registered with the membrane, required by the workers readiness condition
+	WorkerCount func() int
 }
 
-func NewProcessManager(userCommand []string, preCommands [][]string) ProcessManager {
+func NewProcessManager(opts *ProcessManagerOptions) (ProcessManager, error) {
+	log := opts.Logger
+	if log == nil {
+		log = logger.Default()
+	}
+	log = log.WithField(logger.ComponentKey, "process-manager")
+
 	m := &pMgr{
-		userProcess:    &process{Command: userCommand},
+		userProcesses:  []*process{},
 		preProcesses:   []*process{},
-		monitorErrChan: make(chan error),
+		log:            log,
+		readyTimeout:   opts.ReadyTimeout,
+		workerCount:    opts.WorkerCount,
+		restartPolicy:  opts.RestartPolicy,
+		maxRestarts:    opts.MaxRestarts,
+		restartBackoff: opts.RestartBackoff,
+		stopTimeout:    opts.StopTimeout,
+	}
+
+	if m.readyTimeout <= 0 {
+		m.readyTimeout = defaultReadyTimeout
+	}
+
+	if m.restartPolicy == "" {
+		m.restartPolicy = RestartNever
+	}
+
+	if m.maxRestarts < 1 {
+		m.maxRestarts = defaultMaxRestarts
+	}
+
+	if m.restartBackoff <= 0 {
+		m.restartBackoff = defaultRestartBackoff
+	}
+
+	if m.stopTimeout <= 0 {
+		m.stopTimeout = defaultStopTimeout
+	}
+
+	for _, p := range opts.PreCommands {
+		m.preProcesses = append(m.preProcesses, newProcess(ProcessOptions{Command: p}, nil, log))
+	}
+
+	userProcesses := opts.UserProcesses
+	if len(opts.UserCommand) > 0 {
+		userProcesses = append([]ProcessOptions{{Command: opts.UserCommand}}, userProcesses...)
+	}
+
+	names := map[string]bool{}
+	for i, po := range userProcesses {
+		if len(po.Command) == 0 {
+			return nil, fmt.Errorf("user process %s has no command", po.Name)
+		}
+
+		if po.Name != "" {
+			if names[po.Name] {
+				return nil, fmt.Errorf("duplicate user process name %s", po.Name)
+			}
+			names[po.Name] = true
+		}
+
+		ready, err := ParseReadiness(po.Ready)
+		if err != nil {
+			return nil, fmt.Errorf("invalid readiness condition for user process %s: %w", po.Name, err)
+		}
+
+		if ready != nil && ready.Kind == ReadinessWorkers && m.workerCount == nil {
+			return nil, fmt.Errorf("user process %s waits for workers, but worker registrations are not available", po.Name)
+		}
+
+		p := newProcess(po, ready, log)
+		p.Env = append(append([]string{}, opts.UserEnv...), po.Env...)
+		m.userProcesses = append(m.userProcesses, p)
+
+		if i == 0 && len(opts.UserCommand) > 0 {
+			m.userCommand = p
+		}
+	}
+
+	// Only the first exit is returned from Monitor
+	m.monitorErrChan = make(chan error, 1)
+
+	return m, nil
+}
+
+func newProcess(opts ProcessOptions, ready *Readiness, log *logrus.Entry) *process {
+	if opts.Name != "" {
+		log = log.WithField("process", opts.Name)
 	}
 
-	for _, p := range preCommands {
-		m.preProcesses = append(m.preProcesses, &process{Command: p})
+	return &process{
+		Name:    opts.Name,
+		Command: opts.Command,
+		Env:     opts.Env,
+		Dir:     opts.Dir,
+		ready:   ready,
+		stdout:  newPrefixWriter(os.Stdout, opts.Name),
+		stderr:  newPrefixWriter(os.Stderr, opts.Name),
+		log:     log,
+	}
+}
+
+func (pm *pMgr) StartUserProcesses() error {
+	for _, p := range pm.userProcesses {
+		workers := 0
+		if pm.workerCount != nil {
+			workers = pm.workerCount()
+		}
+
+		if err := pm.start(p); err != nil {
+			return err
+		}
+
+		if p.ready == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), pm.readyTimeout)
+		err := pm.waitReady(ctx, p, workers)
+		cancel()
+
+		if err != nil {
+			return err
+		}
+
+		p.log.WithField("ready", p.ready.String()).Info("process is ready")
 	}
 
-	return m
+	return nil
 }
 
-func (pm *pMgr) StartUserProcess() error {
-	return pm.userProcess.start()
+// UserProcessesRunning - without user processes there are none to wait on, e.g. when workers connect from elsewhere
+func (pm *pMgr) UserProcessesRunning() bool {
+	for _, p := range pm.userProcesses {
+		if atomic.LoadInt32(&p.state) != processRunning {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (pm *pMgr) StartPreProcesses() error {
 	for i := range pm.preProcesses {
-		if err := pm.preProcesses[i].start(); err != nil {
+		if err := pm.start(pm.preProcesses[i]); err != nil {
 			return err
 		}
 	}
@@ -70,57 +321,287 @@ func (pm *pMgr) StartPreProcesses() error {
 	return nil
 }
 
+// start - starts the process, unless the process manager is stopping or it is a removed replica
+func (pm *pMgr) start(p *process) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	if pm.stopping {
+		return fmt.Errorf("process manager is stopping")
+	}
+
+	if p.removed {
+		return fmt.Errorf("replica %s has been removed", p.Name)
+	}
+
+	return p.start()
+}
+
+// StopAll - stops the user processes then the pre-processes, each is sent SIGTERM and killed if it hasn't exited within the stop timeout
 func (pm *pMgr) StopAll() {
-	err := pm.userProcess.stop()
-	if err != nil {
-		fmt.Println(err)
+	pm.lock.Lock()
+	pm.stopping = true
+	userProcesses := append(append([]*process{}, pm.userProcesses...), pm.replicas...)
+	pm.lock.Unlock()
+
+	// User processes are stopped together, so the stop timeout applies once
+	wg := sync.WaitGroup{}
+	for _, p := range userProcesses {
+		wg.Add(1)
+
+		go func(p *process) {
+			defer wg.Done()
+
+			if err := p.stop(pm.stopTimeout); err != nil {
+				p.log.WithError(err).Error("could not stop user process")
+			}
+		}(p)
 	}
+	wg.Wait()
 
 	for _, p := range pm.preProcesses {
-		err := p.stop()
+		err := p.stop(pm.stopTimeout)
 		if err != nil {
-			fmt.Println(err)
+			pm.log.WithError(err).WithField("command", p.Command[0]).Error("could not stop pre-process")
 		}
 	}
 }
 
+// RestartUserProcesses - stops the user processes together, which are started again by their supervisors.
+// Restarts count towards the maximum restarts of each process.
+func (pm *pMgr) RestartUserProcesses() error {
+	pm.lock.Lock()
+	if pm.stopping {
+		pm.lock.Unlock()
+		return fmt.Errorf("process manager is stopping")
+	}
+
+	if !pm.monitoring {
+		pm.lock.Unlock()
+		return fmt.Errorf("processes are not being supervised")
+	}
+
+	userProcesses := append(append([]*process{}, pm.userProcesses...), pm.replicas...)
+	for _, p := range userProcesses {
+		p.restarting = true
+	}
+	pm.lock.Unlock()
+
+	pm.log.Warn("restarting user processes")
+
+	errs := make(chan error, len(userProcesses))
+	wg := sync.WaitGroup{}
+	for _, p := range userProcesses {
+		wg.Add(1)
+
+		go func(p *process) {
+			defer wg.Done()
+
+			if err := p.stop(pm.stopTimeout); err != nil {
+				errs <- errors.WithMessagef(err, "could not stop process %s", p.Command[0])
+			}
+		}(p)
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// Monitor - blocks until a process exits and is not restarted according to the restart policy, returning the reason
 func (pm *pMgr) Monitor() error {
-	for _, p := range append(pm.preProcesses, pm.userProcess) {
-		if p.cmd == nil {
+	pm.lock.Lock()
+	pm.monitoring = true
+	processes := append(append(append([]*process{}, pm.preProcesses...), pm.userProcesses...), pm.replicas...)
+	pm.lock.Unlock()
+
+	for _, p := range processes {
+		if cmd, _ := p.current(); cmd == nil {
 			continue
 		}
 
-		go func(c *exec.Cmd) {
-			pm.monitorErrChan <- c.Wait()
-		}(p.cmd)
+		go pm.monitor(p)
 	}
 
 	return <-pm.monitorErrChan
 }
 
+// monitor - supervises the process, reporting the reason it stopped to Monitor unless it was an expected exit of a removed replica
+func (pm *pMgr) monitor(p *process) {
+	err := pm.supervise(p)
+
+	pm.lock.Lock()
+	removed := p.removed
+	pm.lock.Unlock()
+
+	if removed {
+		return
+	}
+
+	select {
+	case pm.monitorErrChan <- err:
+	default:
+		// Monitor has already returned the exit of another process
+	}
+}
+
+// supervise - restarts the process according to the restart policy each time it exits, returning once it is not restarted
+func (pm *pMgr) supervise(p *process) error {
+	restarts := 0
+	backoff := pm.restartBackoff
+
+	for {
+		err := p.wait()
+
+		log := p.log.WithField("command", p.Command[0])
+		if err != nil {
+			log = log.WithError(err)
+		}
+
+		pm.lock.Lock()
+		stopping := pm.stopping || p.removed
+		restarting := p.restarting
+		p.restarting = false
+		pm.lock.Unlock()
+
+		if stopping {
+			log.Info("process stopped")
+			return err
+		}
+
+		if !restarting && !pm.shouldRestart(err) {
+			log.Error("process exited")
+			return exitError(p, err)
+		}
+
+		if restarts >= pm.maxRestarts {
+			log.WithField("restarts", restarts).Error("process exited, restart limit reached")
+			return exitError(p, err)
+		}
+
+		restarts++
+		log.WithFields(logger.Fields{
+			"restarts": restarts,
+			"backoff":  backoff.String(),
+		}).Warn("process exited, restarting")
+
+		time.Sleep(backoff)
+
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+
+		if err := pm.start(p); err != nil {
+			return err
+		}
+	}
+}
+
+func (pm *pMgr) shouldRestart(exitErr error) bool {
+	switch pm.restartPolicy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return exitErr != nil
+	default:
+		return false
+	}
+}
+
+// exitError - the error returned from Monitor for the exit of the process, which is never nil
+func exitError(p *process, err error) error {
+	if err != nil {
+		return errors.WithMessagef(err, "process %s exited", p.Command[0])
+	}
+
+	return fmt.Errorf("process %s exited", p.Command[0])
+}
+
 func (p *process) start() error {
 	if len(p.Command) == 0 {
-		log.Default().Println("No Command Specified, Skipping...")
+		p.log.Info("no command specified, skipping")
 
 		return nil
 	}
 
-	p.cmd = exec.Command(p.Command[0], p.Command[1:]...)
-	p.cmd.Stdout = os.Stdout
-	p.cmd.Stderr = os.Stderr
+	cmd := exec.Command(p.Command[0], p.Command[1:]...)
+	cmd.Stdout = p.stdout
+	cmd.Stderr = p.stderr
+	cmd.Dir = p.Dir
+	// Run in its own process group, so signals also reach the processes it starts
+	setProcessGroup(cmd)
+
+	if len(p.Env) > 0 {
+		cmd.Env = append(os.Environ(), p.Env...)
+	}
+
+	p.log.WithField("command", p.Command[0]).Info("starting process")
+
+	if err := cmd.Start(); err != nil {
+		return errors.WithMessagef(err, "there was an error starting the process %s", p.Command[0])
+	}
+
+	exited := make(chan struct{})
+
+	p.runLock.Lock()
+	p.cmd = cmd
+	p.exited = exited
+	p.runLock.Unlock()
+
+	atomic.StoreInt32(&p.state, processRunning)
+
+	go func() {
+		err := cmd.Wait()
+		p.stdout.Flush()
+		p.stderr.Flush()
+
+		p.runLock.Lock()
+		p.exitErr = err
+		p.runLock.Unlock()
+
+		atomic.StoreInt32(&p.state, processExited)
+		close(exited)
+	}()
+
+	return nil
+}
+
+// current - returns the command and exit channel of the current run of the process
+func (p *process) current() (*exec.Cmd, chan struct{}) {
+	p.runLock.Lock()
+	defer p.runLock.Unlock()
+
+	return p.cmd, p.exited
+}
+
+// wait - waits for the current run of the process to exit, returning its exit error
+func (p *process) wait() error {
+	_, exited := p.current()
+	<-exited
 
-	log.Default().Printf("Starting: %s", p.Command[0])
+	p.runLock.Lock()
+	defer p.runLock.Unlock()
 
-	return errors.WithMessagef(p.cmd.Start(), "there was an error starting the process %s", p.Command[0])
+	return p.exitErr
 }
 
-func (p *process) stop() error {
-	if p == nil || p.cmd == nil {
+// stop - sends SIGTERM to the process group and waits for the process to exit, sending SIGKILL if it hasn't exited within the timeout
+func (p *process) stop(timeout time.Duration) error {
+	if p == nil {
 		return nil
 	}
 
-	err := p.cmd.Process.Signal(syscall.Signal(0))
-	if err != nil {
+	cmd, exited := p.current()
+	if cmd == nil || atomic.LoadInt32(&p.state) != processRunning {
+		return nil
+	}
+
+	log := p.log.WithField("command", p.Command[0])
+
+	log.Info("sending SIGTERM to process")
+
+	if err := signalProcessGroup(cmd, syscall.SIGTERM); err != nil {
 		if errors.Is(err, os.ErrProcessDone) {
 			return nil
 		}
@@ -128,12 +609,19 @@ func (p *process) stop() error {
 		return err
 	}
 
-	err = p.cmd.Process.Signal(syscall.SIGTERM)
-	if err != nil {
-		if !errors.Is(err, os.ErrProcessDone) {
-			return err
-		}
+	select {
+	case <-exited:
+		return nil
+	case <-time.After(timeout):
 	}
 
+	log.WithField("timeout", timeout.String()).Warn("process did not exit in time, sending SIGKILL")
+
+	if err := signalProcessGroup(cmd, syscall.SIGKILL); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+
+	<-exited
+
 	return nil
 }
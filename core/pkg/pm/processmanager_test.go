@@ -0,0 +1,309 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm_test
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/pm"
+)
+
+var _ = Describe("ProcessManager", func() {
+	log, _ := logger.New(&logger.Options{Level: "info", Format: logger.FormatJSON, Output: io.Discard})
+
+	Context("RestartPolicyFromString", func() {
+		It("should parse known policies", func() {
+			policy, err := pm.RestartPolicyFromString("on-failure")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(policy).To(Equal(pm.RestartOnFailure))
+		})
+
+		It("should reject unknown policies", func() {
+			_, err := pm.RestartPolicyFromString("sometimes")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Monitor", func() {
+		When("the restart policy is never", func() {
+			It("should return when the process exits", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand: []string{"true"},
+					Logger:      log,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(m.Monitor()).Should(MatchError(ContainSubstring("process true exited")))
+			})
+		})
+
+		When("the restart policy is on-failure", func() {
+			It("should restart the process up to the maximum restarts", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand:    []string{"false"},
+					Logger:         log,
+					RestartPolicy:  pm.RestartOnFailure,
+					MaxRestarts:    2,
+					RestartBackoff: time.Millisecond,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+
+				start := time.Now()
+				Expect(m.Monitor()).Should(MatchError(ContainSubstring("process false exited")))
+				// Backoff doubles between restarts
+				Expect(time.Since(start)).To(BeNumerically(">=", 3*time.Millisecond))
+			})
+
+			It("should not restart a process that exits successfully", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand:   []string{"true"},
+					Logger:        log,
+					RestartPolicy: pm.RestartOnFailure,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(m.Monitor()).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("StartUserProcesses", func() {
+		When("a process has a readiness condition", func() {
+			It("should wait for it to be met", func() {
+				lis, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).ShouldNot(HaveOccurred())
+				addr := lis.Addr().String()
+				Expect(lis.Close()).To(Succeed())
+
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserProcesses: []pm.ProcessOptions{{
+						Name:    "web",
+						Command: []string{"sleep", "5"},
+						Ready:   "tcp://" + addr,
+					}},
+					Logger:       log,
+					ReadyTimeout: 5 * time.Second,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer m.StopAll()
+
+				// Start listening some time after the process has started
+				listening := make(chan net.Listener, 1)
+				time.AfterFunc(200*time.Millisecond, func() {
+					lis, _ := net.Listen("tcp", addr)
+					listening <- lis
+				})
+
+				start := time.Now()
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+				Expect((<-listening).Close()).To(Succeed())
+			})
+		})
+
+		When("a process exits before it is ready", func() {
+			It("should return an error", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserProcesses: []pm.ProcessOptions{{
+						Name:    "web",
+						Command: []string{"true"},
+						Ready:   "http://127.0.0.1:1/healthz",
+					}},
+					Logger:       log,
+					ReadyTimeout: 5 * time.Second,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.StartUserProcesses()).Should(MatchError(ContainSubstring("exited before it was ready")))
+			})
+		})
+
+		When("a process waits for workers", func() {
+			It("should be ready once a worker registers", func() {
+				var workers int32
+
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserProcesses: []pm.ProcessOptions{{
+						Name:    "consumer",
+						Command: []string{"sleep", "5"},
+						Ready:   "workers",
+					}},
+					Logger:       log,
+					ReadyTimeout: 5 * time.Second,
+					WorkerCount:  func() int { return int(atomic.LoadInt32(&workers)) },
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer m.StopAll()
+
+				time.AfterFunc(100*time.Millisecond, func() { atomic.AddInt32(&workers, 1) })
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(m.UserProcessesRunning()).To(BeTrue())
+			})
+		})
+
+		When("user processes are misconfigured", func() {
+			It("should reject duplicate names", func() {
+				_, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserProcesses: []pm.ProcessOptions{
+						{Name: "web", Command: []string{"true"}},
+						{Name: "web", Command: []string{"true"}},
+					},
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should reject unknown readiness conditions", func() {
+				_, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserProcesses: []pm.ProcessOptions{
+						{Name: "web", Command: []string{"true"}, Ready: "udp://localhost:80"},
+					},
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Replicas", func() {
+		When("a replica is removed", func() {
+			It("should not be reported as an exit by Monitor", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand: []string{"sleep", "5"},
+					Logger:      log,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer m.StopAll()
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(m.Replicas()).To(Equal(1))
+
+				monitorErr := make(chan error, 1)
+				go func() {
+					monitorErr <- m.Monitor()
+				}()
+
+				Expect(m.AddReplica()).To(Succeed())
+				Expect(m.Replicas()).To(Equal(2))
+
+				Expect(m.RemoveReplica()).To(Succeed())
+				Expect(m.Replicas()).To(Equal(1))
+
+				Consistently(monitorErr, 200*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+
+		When("there is no user command", func() {
+			It("should not add replicas", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{Logger: log})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.AddReplica()).ShouldNot(Succeed())
+				Expect(m.Replicas()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("RestartUserProcesses", func() {
+		When("the restart policy is never", func() {
+			It("should start the user processes again", func() {
+				dir, err := os.MkdirTemp("", "pm")
+				Expect(err).ShouldNot(HaveOccurred())
+				defer os.RemoveAll(dir)
+
+				starts := filepath.Join(dir, "starts")
+
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand:    []string{"sh", "-c", "echo started >> " + starts + "; exec sleep 5"},
+					Logger:         log,
+					RestartBackoff: time.Millisecond,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer m.StopAll()
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+
+				monitorErr := make(chan error, 1)
+				go func() {
+					monitorErr <- m.Monitor()
+				}()
+
+				Eventually(m.RestartUserProcesses).Should(Succeed())
+
+				startCount := func() int {
+					data, _ := os.ReadFile(starts)
+					return strings.Count(string(data), "started")
+				}
+				Eventually(startCount).Should(Equal(2))
+				Eventually(m.UserProcessesRunning).Should(BeTrue())
+
+				By("not reporting the restart as an exit")
+				Consistently(monitorErr, 100*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+
+		When("processes are not being supervised", func() {
+			It("should return an error", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand: []string{"sleep", "5"},
+					Logger:      log,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				defer m.StopAll()
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Expect(m.RestartUserProcesses()).ShouldNot(Succeed())
+			})
+		})
+	})
+
+	Context("StopAll", func() {
+		When("the process ignores SIGTERM", func() {
+			It("should kill it after the stop timeout", func() {
+				m, err := pm.NewProcessManager(&pm.ProcessManagerOptions{
+					UserCommand: []string{"sh", "-c", "trap '' TERM; sleep 30 & wait"},
+					Logger:      log,
+					StopTimeout: 50 * time.Millisecond,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(m.StartUserProcesses()).To(Succeed())
+				Eventually(m.UserProcessesRunning).Should(BeTrue())
+
+				stopped := make(chan struct{})
+				go func() {
+					m.StopAll()
+					close(stopped)
+				}()
+
+				Eventually(stopped, 5*time.Second).Should(BeClosed())
+				Expect(m.UserProcessesRunning()).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,129 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+// ReadinessKind - how a user process is determined to be ready
+type ReadinessKind string
+
+const (
+	// ReadinessTCP - ready once a TCP connection can be made to the address
+	ReadinessTCP ReadinessKind = "tcp"
+	// ReadinessHTTP - ready once a GET request to the URL returns a status below 400
+	ReadinessHTTP ReadinessKind = "http"
+	// ReadinessWorkers - ready once a worker has registered with the membrane after the process started
+	ReadinessWorkers ReadinessKind = "workers"
+)
+
+// Readiness - the condition for a user process to be ready
+type Readiness struct {
+	Kind ReadinessKind
+	// The address for tcp, or the URL for http
+	Target string
+}
+
+func (r *Readiness) String() string {
+	if r.Kind == ReadinessWorkers {
+		return string(r.Kind)
+	}
+
+	return r.Target
+}
+
+// ParseReadiness - parses a readiness condition, one of tcp://host:port, http://host:port/path or workers, returns nil if empty
+func ParseReadiness(ready string) (*Readiness, error) {
+	if ready == "" {
+		return nil, nil
+	}
+
+	if ready == string(ReadinessWorkers) {
+		return &Readiness{Kind: ReadinessWorkers}, nil
+	}
+
+	u, err := url.Parse(ready)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		if u.Host == "" {
+			return nil, fmt.Errorf("expected tcp://host:port, got %s", ready)
+		}
+
+		return &Readiness{Kind: ReadinessTCP, Target: u.Host}, nil
+	case "http", "https":
+		return &Readiness{Kind: ReadinessHTTP, Target: ready}, nil
+	default:
+		return nil, fmt.Errorf("unknown readiness condition %s, expected tcp://host:port, http://host:port/path or workers", ready)
+	}
+}
+
+// check - returns true if the readiness condition is met, workers is the number registered before the process started
+func (pm *pMgr) check(r *Readiness, workers int) bool {
+	switch r.Kind {
+	case ReadinessTCP:
+		conn, err := net.DialTimeout("tcp", r.Target, time.Second)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+
+		return true
+	case ReadinessHTTP:
+		client := http.Client{Timeout: time.Second}
+
+		resp, err := client.Get(r.Target)
+		if err != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+
+		return resp.StatusCode < 400
+	case ReadinessWorkers:
+		return pm.workerCount() > workers
+	default:
+		return false
+	}
+}
+
+// waitReady - blocks until the process is ready, returning an error if it exits or ctx is done first
+func (pm *pMgr) waitReady(ctx context.Context, p *process, workers int) error {
+	ticker := time.NewTicker(time.Duration(50) * time.Millisecond)
+	defer ticker.Stop()
+
+	for !pm.check(p.ready, workers) {
+		if atomic.LoadInt32(&p.state) == processExited {
+			return fmt.Errorf("process %s exited before it was ready", p.Command[0])
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("process %s was not ready (%s) in time: %w", p.Command[0], p.ready, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
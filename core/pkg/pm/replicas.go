@@ -0,0 +1,119 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReplicaManager - runs additional copies of the user command, e.g. to handle triggers concurrently with single threaded runtimes
+type ReplicaManager interface {
+	// AddReplica - starts a copy of the user command, returning once it has registered a worker
+	AddReplica() error
+	// RemoveReplica - stops the most recently added replica
+	RemoveReplica() error
+	// Replicas - the number of copies of the user command running, including the original
+	Replicas() int
+}
+
+func (pm *pMgr) AddReplica() error {
+	if pm.userCommand == nil {
+		return fmt.Errorf("there is no user command to replicate")
+	}
+
+	pm.lock.Lock()
+	pm.replicaCount++
+	name := fmt.Sprintf("replica-%d", pm.replicaCount)
+	pm.lock.Unlock()
+
+	var ready *Readiness
+	workers := 0
+	if pm.workerCount != nil {
+		ready = &Readiness{Kind: ReadinessWorkers}
+		workers = pm.workerCount()
+	}
+
+	p := newProcess(ProcessOptions{
+		Name:    name,
+		Command: pm.userCommand.Command,
+		Dir:     pm.userCommand.Dir,
+	}, ready, pm.log)
+	p.Env = pm.userCommand.Env
+
+	if err := pm.start(p); err != nil {
+		return err
+	}
+
+	if ready != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), pm.readyTimeout)
+		err := pm.waitReady(ctx, p, workers)
+		cancel()
+
+		if err != nil {
+			_ = p.stop(pm.stopTimeout)
+			return err
+		}
+	}
+
+	pm.lock.Lock()
+	if pm.stopping {
+		pm.lock.Unlock()
+		// StopAll started while the replica was starting, so it won't have been stopped
+		_ = p.stop(pm.stopTimeout)
+
+		return fmt.Errorf("process manager is stopping")
+	}
+
+	pm.replicas = append(pm.replicas, p)
+	monitoring := pm.monitoring
+	pm.lock.Unlock()
+
+	if monitoring {
+		go pm.monitor(p)
+	}
+
+	p.log.Info("replica added")
+
+	return nil
+}
+
+func (pm *pMgr) RemoveReplica() error {
+	pm.lock.Lock()
+	if len(pm.replicas) == 0 {
+		pm.lock.Unlock()
+		return fmt.Errorf("there are no replicas to remove")
+	}
+
+	p := pm.replicas[len(pm.replicas)-1]
+	pm.replicas = pm.replicas[:len(pm.replicas)-1]
+	p.removed = true
+	pm.lock.Unlock()
+
+	p.log.Info("removing replica")
+
+	return p.stop(pm.stopTimeout)
+}
+
+func (pm *pMgr) Replicas() int {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+
+	if pm.userCommand == nil {
+		return 0
+	}
+
+	return len(pm.replicas) + 1
+}
@@ -0,0 +1,283 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cadence - when a schedule fires
+type Cadence interface {
+	// Next - returns the first time the schedule fires after t
+	Next(t time.Time) time.Time
+}
+
+// ParseCadence - parses the cadence a schedule worker was registered with, exactly one of rate or cron must be set
+func ParseCadence(rate string, cron string) (Cadence, error) {
+	switch {
+	case rate != "" && cron != "":
+		return nil, fmt.Errorf("schedule has both a rate and a cron expression")
+	case rate != "":
+		return ParseRate(rate)
+	case cron != "":
+		return ParseCron(cron)
+	default:
+		return nil, fmt.Errorf("schedule has no rate or cron expression")
+	}
+}
+
+// rateCadence - fires at a fixed interval
+type rateCadence struct {
+	interval time.Duration
+}
+
+func (r *rateCadence) Next(t time.Time) time.Time {
+	return t.Add(r.interval)
+}
+
+var rateUnits = map[string]time.Duration{
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"day":     24 * time.Hour,
+	"days":    24 * time.Hour,
+}
+
+// ParseRate - parses a rate of the form "<count> <unit>", e.g. "5 minutes", where unit is minutes, hours or days.
+// The count may be omitted for a rate of 1, e.g. "day".
+func ParseRate(rate string) (Cadence, error) {
+	fields := strings.Fields(strings.ToLower(rate))
+
+	count := 1
+	switch len(fields) {
+	case 1:
+	case 2:
+		var err error
+		count, err = strconv.Atoi(fields[0])
+		if err != nil || count < 1 {
+			return nil, fmt.Errorf("invalid rate %q, expected a positive count", rate)
+		}
+		fields = fields[1:]
+	default:
+		return nil, fmt.Errorf("invalid rate %q, expected e.g. \"5 minutes\"", rate)
+	}
+
+	unit, ok := rateUnits[fields[0]]
+	if !ok {
+		return nil, fmt.Errorf("invalid rate %q, expected minutes, hours or days", rate)
+	}
+
+	return &rateCadence{interval: time.Duration(count) * unit}, nil
+}
+
+// cronCadence - fires at the times matching a cron expression, evaluated in UTC
+type cronCadence struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// Either day field is unrestricted, so both must match, otherwise a day matches if either does
+	anyDay bool
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is also accepted for sunday
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// value - parses a single value of the field, either a number or a name
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q, expected %d-%d", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// parse - parses a comma separated list of values, ranges and steps into a bit set of the matching values.
+// Returns true if the field is unrestricted.
+func (f cronField) parse(expr string) (uint64, bool, error) {
+	var bits uint64
+
+	if expr == "*" || expr == "?" {
+		for v := f.min; v <= f.max; v++ {
+			bits |= 1 << uint(v)
+		}
+
+		return bits, true, nil
+	}
+
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, step := part, 1
+
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangeExpr = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, false, fmt.Errorf("invalid %s step %q", f.name, part)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangeExpr == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+
+			var err error
+			if start, err = f.value(bounds[0]); err != nil {
+				return 0, false, err
+			}
+			if end, err = f.value(bounds[1]); err != nil {
+				return 0, false, err
+			}
+			if end < start {
+				return 0, false, fmt.Errorf("invalid %s range %q", f.name, rangeExpr)
+			}
+		default:
+			var err error
+			if start, err = f.value(rangeExpr); err != nil {
+				return 0, false, err
+			}
+
+			end = start
+			// A step from a single value continues to the end of the field, e.g. 5/15
+			if step > 1 {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, false, nil
+}
+
+// ParseCron - parses a standard 5 field cron expression: minute, hour, day of month, month and day of week.
+// Fields support *, values, names, ranges, steps and lists, e.g. "*/15 9-17 * * MON-FRI". Times are in UTC.
+func ParseCron(expr string) (Cadence, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q, expected 5 fields", expr)
+	}
+
+	c := &cronCadence{}
+
+	var err error
+	var anyDom, anyDow bool
+
+	if c.minute, _, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hour, _, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.dom, anyDom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.month, _, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.dow, anyDow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+
+	// Sunday may be given as 7
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+
+	c.anyDay = anyDom || anyDow
+
+	return c, nil
+}
+
+func (c *cronCadence) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.anyDay {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// cronSearchLimit - the years searched ahead for a match, expressions that never match such as "0 0 30 2 *" are abandoned after this
+const cronSearchLimit = 5
+
+func (c *cronCadence) Next(t time.Time) time.Time {
+	t = t.UTC()
+	// The next whole minute after t
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC).Add(time.Minute)
+	limit := t.AddDate(cronSearchLimit, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	// The expression never matches
+	return time.Time{}
+}
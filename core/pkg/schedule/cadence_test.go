@@ -0,0 +1,101 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cadence", func() {
+	// A monday
+	start := time.Date(2022, time.January, 3, 10, 7, 30, 0, time.UTC)
+
+	Context("ParseRate", func() {
+		It("should fire at the given interval", func() {
+			c, err := ParseRate("5 minutes")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Next(start)).To(Equal(start.Add(5 * time.Minute)))
+		})
+
+		It("should default the count to 1", func() {
+			c, err := ParseRate("day")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Next(start)).To(Equal(start.Add(24 * time.Hour)))
+		})
+
+		It("should reject invalid rates", func() {
+			for _, rate := range []string{"", "0 minutes", "5 weeks", "five minutes", "every 5 minutes"} {
+				_, err := ParseRate(rate)
+				Expect(err).To(HaveOccurred(), rate)
+			}
+		})
+	})
+
+	Context("ParseCron", func() {
+		next := func(expr string, t time.Time) time.Time {
+			c, err := ParseCron(expr)
+			Expect(err).ToNot(HaveOccurred())
+
+			return c.Next(t)
+		}
+
+		It("should fire at the next matching minute", func() {
+			Expect(next("* * * * *", start)).To(Equal(time.Date(2022, time.January, 3, 10, 8, 0, 0, time.UTC)))
+			Expect(next("*/15 * * * *", start)).To(Equal(time.Date(2022, time.January, 3, 10, 15, 0, 0, time.UTC)))
+			Expect(next("0 9 * * *", start)).To(Equal(time.Date(2022, time.January, 4, 9, 0, 0, 0, time.UTC)))
+		})
+
+		It("should support lists, ranges and names", func() {
+			Expect(next("0,30 9-17 * * MON-FRI", time.Date(2022, time.January, 7, 17, 30, 0, 0, time.UTC))).
+				To(Equal(time.Date(2022, time.January, 10, 9, 0, 0, 0, time.UTC)))
+			Expect(next("0 0 1 jun *", start)).To(Equal(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(next("0 0 * * 7", start)).To(Equal(time.Date(2022, time.January, 9, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should match either day field when both are restricted", func() {
+			// The 15th, or any friday
+			Expect(next("0 0 15 * 5", start)).To(Equal(time.Date(2022, time.January, 7, 0, 0, 0, 0, time.UTC)))
+			Expect(next("0 0 15 * 5", time.Date(2022, time.January, 14, 1, 0, 0, 0, time.UTC))).
+				To(Equal(time.Date(2022, time.January, 15, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the zero time for expressions that never match", func() {
+			Expect(next("0 0 30 feb *", start).IsZero()).To(BeTrue())
+		})
+
+		It("should reject invalid expressions", func() {
+			for _, expr := range []string{"* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+				_, err := ParseCron(expr)
+				Expect(err).To(HaveOccurred(), expr)
+			}
+		})
+	})
+
+	Context("ParseCadence", func() {
+		It("should require exactly one of rate or cron", func() {
+			_, err := ParseCadence("", "")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseCadence("5 minutes", "* * * * *")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseCadence("", "* * * * *")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,217 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+const tickInterval = time.Second
+
+// Options - configures a Scheduler
+type Options struct {
+	// The maximum random delay added to each firing, spreading schedules that are due at the same time
+	Jitter time.Duration
+	// Fire a schedule while its previous firing is still being handled, otherwise the firing is skipped
+	AllowOverlap bool
+	// The time a firing may take to be handled, defaults to the time until the schedule is next due if 0
+	Timeout time.Duration
+	Logger  *logrus.Entry
+}
+
+// Scheduler - fires the schedules registered by the schedule workers of a pool at their rate or cron cadence,
+// for deployments without a cloud scheduler
+type Scheduler struct {
+	pool         worker.WorkerPool
+	jitter       time.Duration
+	allowOverlap bool
+	timeout      time.Duration
+	log          *logrus.Entry
+
+	lock      sync.Mutex
+	schedules map[string]*entry
+}
+
+// entry - the state of a single schedule
+type entry struct {
+	key string
+	// The rate or cron expression the cadence was parsed from, a schedule is reset if its expression changes
+	expr    string
+	cadence Cadence
+	// The time the schedule is next due
+	scheduled time.Time
+	// The time the schedule next fires, the scheduled time plus jitter
+	fireAt  time.Time
+	running int32
+}
+
+// New - creates a scheduler for the schedule workers of the pool
+func New(pool worker.WorkerPool, opts *Options) *Scheduler {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	log := opts.Logger
+	if log == nil {
+		log = logger.Default()
+	}
+
+	return &Scheduler{
+		pool:         pool,
+		jitter:       opts.Jitter,
+		allowOverlap: opts.AllowOverlap,
+		timeout:      opts.Timeout,
+		log:          log.WithField(logger.ComponentKey, "scheduler"),
+		schedules:    map[string]*entry{},
+	}
+}
+
+// Run - fires due schedules at each interval until done is closed
+func (s *Scheduler) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	s.tick(time.Now())
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			s.tick(now)
+		}
+	}
+}
+
+// tick - syncs the schedules with the pool's workers and fires those that are due
+func (s *Scheduler) tick(now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sync(now)
+
+	for _, e := range s.schedules {
+		if e.cadence == nil || e.fireAt.IsZero() || now.Before(e.fireAt) {
+			continue
+		}
+
+		scheduled := e.scheduled
+		s.advance(e, now)
+
+		if !s.allowOverlap && !atomic.CompareAndSwapInt32(&e.running, 0, 1) {
+			s.log.WithFields(logger.Fields{
+				"schedule":       e.key,
+				"scheduled_time": scheduled,
+			}).Warn("skipping schedule, its previous run has not finished")
+
+			continue
+		}
+
+		timeout := s.timeout
+		if timeout <= 0 {
+			// A firing is abandoned once the schedule is next due, so a lost response can't block it forever
+			timeout = e.scheduled.Sub(scheduled)
+		}
+
+		go s.fire(e, scheduled, now, timeout)
+	}
+}
+
+// sync - tracks the schedules of the pool's schedule workers, dropping those that are no longer registered
+func (s *Scheduler) sync(now time.Time) {
+	registered := map[string]bool{}
+
+	for _, w := range s.pool.GetWorkers(&worker.GetWorkerOptions{}) {
+		sw, ok := worker.BaseWorker(w).(*worker.ScheduleWorker)
+		if !ok || (sw.Rate() == "" && sw.Cron() == "") {
+			continue
+		}
+
+		key := sw.Key()
+		expr := sw.Rate() + sw.Cron()
+		registered[key] = true
+
+		if e, ok := s.schedules[key]; ok && e.expr == expr {
+			continue
+		}
+
+		e := &entry{key: key, expr: expr}
+		s.schedules[key] = e
+
+		cadence, err := ParseCadence(sw.Rate(), sw.Cron())
+		if err != nil {
+			// Tracked without a cadence so the error is only logged once
+			logger.WithError(s.log, err).WithField("schedule", key).Error("schedule will not run")
+			continue
+		}
+
+		e.cadence = cadence
+		e.scheduled = now
+		s.advance(e, now)
+	}
+
+	for key := range s.schedules {
+		if !registered[key] {
+			delete(s.schedules, key)
+		}
+	}
+}
+
+// advance - moves the schedule to its first scheduled time after now, missed times are not fired
+func (s *Scheduler) advance(e *entry, now time.Time) {
+	for !e.scheduled.IsZero() && !e.scheduled.After(now) {
+		e.scheduled = e.cadence.Next(e.scheduled)
+	}
+
+	e.fireAt = e.scheduled
+	if s.jitter > 0 && !e.scheduled.IsZero() {
+		e.fireAt = e.scheduled.Add(time.Duration(rand.Int63n(int64(s.jitter))))
+	}
+}
+
+// fire - dispatches the schedule to its workers, giving up once the timeout passes
+func (s *Scheduler) fire(e *entry, scheduled time.Time, now time.Time, timeout time.Duration) {
+	defer atomic.StoreInt32(&e.running, 0)
+
+	log := s.log.WithField("schedule", e.key)
+	ctx, cancel := context.WithTimeout(logger.WithContext(context.Background(), log), timeout)
+	defer cancel()
+
+	err := worker.DispatchSchedule(ctx, s.pool, &triggers.Schedule{
+		Key:           e.key,
+		ScheduledTime: scheduled,
+		FireTime:      now,
+		Cadence:       e.expr,
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.WithFields(logger.Fields{
+			"scheduled_time": scheduled,
+			"timeout":        timeout,
+		}).Warn("schedule timed out")
+	} else if err != nil {
+		logger.WithError(log, err).Error("error running schedule")
+	}
+}
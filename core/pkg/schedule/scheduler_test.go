@@ -0,0 +1,163 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/worker"
+)
+
+// scheduleAdapter - records the schedules fired to it, blocking each until release is closed or the firing times out
+type scheduleAdapter struct {
+	fired   chan *triggers.Schedule
+	release chan struct{}
+}
+
+func (a *scheduleAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return fmt.Errorf("unexpected event")
+}
+
+func (a *scheduleAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("unexpected http request")
+}
+
+func (a *scheduleAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	a.fired <- trigger
+
+	select {
+	case <-a.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var _ = Describe("Scheduler", func() {
+	var adapter *scheduleAdapter
+	var pool worker.WorkerPool
+	start := time.Date(2022, time.January, 3, 10, 7, 30, 0, time.UTC)
+
+	BeforeEach(func() {
+		adapter = &scheduleAdapter{
+			fired:   make(chan *triggers.Schedule, 10),
+			release: make(chan struct{}),
+		}
+		pool = worker.NewProcessPool(&worker.ProcessPoolOptions{MaxWorkers: 10})
+		Expect(pool.AddWorker(worker.NewScheduleWorker(adapter, &worker.ScheduleWorkerOptions{
+			Key:  "cleanup",
+			Rate: "5 minutes",
+		}))).To(Succeed())
+	})
+
+	When("a schedule is due", func() {
+		It("should fire it with its scheduled and fire times", func() {
+			defer close(adapter.release)
+			s := New(pool, nil)
+
+			s.tick(start)
+			Consistently(adapter.fired, "50ms").ShouldNot(Receive())
+
+			fireTime := start.Add(5*time.Minute + time.Second)
+			s.tick(fireTime)
+
+			var fired *triggers.Schedule
+			Eventually(adapter.fired).Should(Receive(&fired))
+			Expect(fired.Key).To(Equal("cleanup"))
+			Expect(fired.ScheduledTime).To(Equal(start.Add(5 * time.Minute)))
+			Expect(fired.FireTime).To(Equal(fireTime))
+		})
+	})
+
+	When("the previous run has not finished", func() {
+		It("should skip the firing", func() {
+			defer close(adapter.release)
+			s := New(pool, nil)
+
+			s.tick(start)
+			s.tick(start.Add(5 * time.Minute))
+			Eventually(adapter.fired).Should(Receive())
+
+			s.tick(start.Add(10 * time.Minute))
+			Consistently(adapter.fired, "50ms").ShouldNot(Receive())
+		})
+
+		It("should fire when overlap is allowed", func() {
+			defer close(adapter.release)
+			s := New(pool, &Options{AllowOverlap: true})
+
+			s.tick(start)
+			s.tick(start.Add(5 * time.Minute))
+			s.tick(start.Add(10 * time.Minute))
+			Eventually(adapter.fired).Should(Receive())
+			Eventually(adapter.fired).Should(Receive())
+		})
+
+		It("should fire once the previous run times out", func() {
+			defer close(adapter.release)
+			s := New(pool, &Options{Timeout: 20 * time.Millisecond})
+
+			s.tick(start)
+			s.tick(start.Add(5 * time.Minute))
+			Eventually(adapter.fired).Should(Receive())
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&s.schedules["cleanup"].running)
+			}).Should(BeZero())
+
+			s.tick(start.Add(10 * time.Minute))
+			Eventually(adapter.fired).Should(Receive())
+		})
+	})
+
+	When("jitter is set", func() {
+		It("should fire within the jitter of the scheduled time", func() {
+			close(adapter.release)
+			s := New(pool, &Options{Jitter: time.Minute})
+
+			s.tick(start)
+			e := s.schedules["cleanup"]
+			Expect(e.fireAt).To(BeTemporally(">=", e.scheduled))
+			Expect(e.fireAt).To(BeTemporally("<", e.scheduled.Add(time.Minute)))
+
+			s.tick(start.Add(6 * time.Minute))
+			Eventually(adapter.fired).Should(Receive())
+		})
+	})
+
+	When("the schedule's workers are removed", func() {
+		It("should stop tracking the schedule", func() {
+			close(adapter.release)
+			s := New(pool, nil)
+
+			s.tick(start)
+			Expect(s.schedules).To(HaveKey("cleanup"))
+
+			for _, w := range pool.GetWorkers(&worker.GetWorkerOptions{}) {
+				Expect(pool.RemoveWorker(w)).To(Succeed())
+			}
+
+			s.tick(start.Add(5 * time.Minute))
+			Expect(s.schedules).To(BeEmpty())
+			Consistently(adapter.fired, "50ms").ShouldNot(Receive())
+		})
+	})
+})
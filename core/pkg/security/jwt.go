@@ -0,0 +1,293 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// AuthError - A failed authorization, carrying the HTTP status that should be returned to the caller
+type AuthError struct {
+	Status int
+	Reason string
+}
+
+func (e *AuthError) Error() string {
+	return e.Reason
+}
+
+// StatusCode - the HTTP status for this error, 401 for missing or invalid tokens, 403 for insufficient scopes
+// and 503 when the issuer's signing keys could not be retrieved to validate the token
+func (e *AuthError) StatusCode() int {
+	return e.Status
+}
+
+func unauthorized(format string, a ...interface{}) *AuthError {
+	return &AuthError{Status: http.StatusUnauthorized, Reason: fmt.Sprintf(format, a...)}
+}
+
+func forbidden(format string, a ...interface{}) *AuthError {
+	return &AuthError{Status: http.StatusForbidden, Reason: fmt.Sprintf(format, a...)}
+}
+
+// unavailable - the token could not be validated, the reason is returned to the caller so it must not include internal details
+func unavailable(reason string) *AuthError {
+	return &AuthError{Status: http.StatusServiceUnavailable, Reason: reason}
+}
+
+// ApiDefinitions - The ApiResources declared by the application, keyed by API name
+type ApiDefinitions struct {
+	lock sync.RWMutex
+	apis map[string]*v1.ApiResource
+}
+
+// Declare - Records the resource declared for the named api, replacing any previous declaration
+func (d *ApiDefinitions) Declare(name string, api *v1.ApiResource) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.apis[name] = api
+}
+
+// Get - Returns the resource declared for the named api, or nil if it has not been declared
+func (d *ApiDefinitions) Get(name string) *v1.ApiResource {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	return d.apis[name]
+}
+
+func NewApiDefinitions() *ApiDefinitions {
+	return &ApiDefinitions{
+		apis: make(map[string]*v1.ApiResource),
+	}
+}
+
+// JwtAuthorizer - Validates bearer tokens against the JWT security definitions of declared APIs
+type JwtAuthorizer struct {
+	definitions *ApiDefinitions
+	client      *http.Client
+
+	jwksLock sync.Mutex
+	jwks     map[string]*keyfunc.JWKS
+}
+
+type openIdConfiguration struct {
+	JwksUri string `json:"jwks_uri"`
+}
+
+// keysForIssuer - returns the signing keys of the issuer, discovered through its OpenID configuration and cached for later requests
+func (a *JwtAuthorizer) keysForIssuer(issuer string) (*keyfunc.JWKS, error) {
+	a.jwksLock.Lock()
+	jwks, ok := a.jwks[issuer]
+	a.jwksLock.Unlock()
+
+	if ok {
+		return jwks, nil
+	}
+
+	// The keys are fetched without holding the lock, so a slow issuer doesn't block requests for other issuers
+	jwks, err := a.fetchKeys(issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	a.jwksLock.Lock()
+	defer a.jwksLock.Unlock()
+
+	if cached, ok := a.jwks[issuer]; ok {
+		// Another request fetched the keys first, stop refreshing the duplicate
+		jwks.EndBackground()
+		return cached, nil
+	}
+
+	a.jwks[issuer] = jwks
+
+	return jwks, nil
+}
+
+// fetchKeys - retrieves the signing keys of the issuer from the jwks_uri of its OpenID configuration
+func (a *JwtAuthorizer) fetchKeys(issuer string) (*keyfunc.JWKS, error) {
+	resp, err := a.client.Get(strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve openid configuration for issuer %s: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve openid configuration for issuer %s: status %d", issuer, resp.StatusCode)
+	}
+
+	config := &openIdConfiguration{}
+	if err := json.NewDecoder(resp.Body).Decode(config); err != nil {
+		return nil, fmt.Errorf("invalid openid configuration for issuer %s: %w", issuer, err)
+	}
+
+	jwks, err := keyfunc.Get(config.JwksUri, keyfunc.Options{
+		Client:            a.client,
+		RefreshInterval:   time.Hour,
+		RefreshRateLimit:  time.Minute * 5,
+		RefreshUnknownKID: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve signing keys for issuer %s: %w", issuer, err)
+	}
+
+	return jwks, nil
+}
+
+func bearerToken(header map[string][]string) string {
+	for k, vals := range header {
+		if !strings.EqualFold(k, "Authorization") {
+			continue
+		}
+
+		for _, v := range vals {
+			if len(v) > 7 && strings.EqualFold(v[:7], "Bearer ") {
+				return strings.TrimSpace(v[7:])
+			}
+		}
+	}
+
+	return ""
+}
+
+// tokenScopes - returns the scopes granted by the token, from either the space delimited 'scope' claim or the 'scp' claim
+func tokenScopes(claims jwt.MapClaims) map[string]bool {
+	scopes := make(map[string]bool)
+
+	for _, key := range []string{"scope", "scp"} {
+		switch v := claims[key].(type) {
+		case string:
+			for _, s := range strings.Fields(v) {
+				scopes[s] = true
+			}
+		case []interface{}:
+			for _, s := range v {
+				if str, ok := s.(string); ok {
+					scopes[str] = true
+				}
+			}
+		}
+	}
+
+	return scopes
+}
+
+// validate - validates a token against a single JWT security definition and its required scopes
+func (a *JwtAuthorizer) validate(ctx context.Context, token string, definition *v1.ApiSecurityDefinitionJwt, scopes []string) (jwt.MapClaims, *AuthError) {
+	jwks, err := a.keysForIssuer(definition.GetIssuer())
+	if err != nil {
+		// The token may be valid, but the keys to validate it with are unavailable
+		logger.WithError(logger.FromContext(ctx), err).Error("unable to retrieve signing keys to validate token")
+		return nil, unavailable("unable to validate token")
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, jwks.Keyfunc); err != nil {
+		return nil, unauthorized("invalid token: %v", err)
+	}
+
+	if !claims.VerifyIssuer(definition.GetIssuer(), true) {
+		return nil, unauthorized("invalid token: unexpected issuer")
+	}
+
+	if len(definition.GetAudiences()) > 0 {
+		validAudience := false
+		for _, aud := range definition.GetAudiences() {
+			if claims.VerifyAudience(aud, true) {
+				validAudience = true
+				break
+			}
+		}
+
+		if !validAudience {
+			return nil, unauthorized("invalid token: unexpected audience")
+		}
+	}
+
+	granted := tokenScopes(claims)
+	for _, s := range scopes {
+		if !granted[s] {
+			return nil, forbidden("token is missing required scope %s", s)
+		}
+	}
+
+	return claims, nil
+}
+
+// Authorize - Validates the bearer token in the given headers against the security requirements of a route.
+// Requirements map security definition names to required scopes, at least one requirement must be satisfied.
+// If no requirements are provided the root level security of the api is applied.
+// Returns the validated token claims, or nil if the route is not secured.
+func (a *JwtAuthorizer) Authorize(ctx context.Context, api string, requirements map[string][]string, header map[string][]string) (map[string]interface{}, error) {
+	apiResource := a.definitions.Get(api)
+
+	if len(requirements) == 0 && apiResource != nil {
+		requirements = make(map[string][]string)
+		for name, scopes := range apiResource.GetSecurity() {
+			requirements[name] = scopes.GetScopes()
+		}
+	}
+
+	if len(requirements) == 0 {
+		return nil, nil
+	}
+
+	token := bearerToken(header)
+	if token == "" {
+		return nil, unauthorized("missing bearer token")
+	}
+
+	var lastErr *AuthError
+	for name, scopes := range requirements {
+		definition := apiResource.GetSecurityDefinitions()[name]
+		if definition.GetJwt() == nil {
+			return nil, fmt.Errorf("api %s has no jwt security definition named %s", api, name)
+		}
+
+		claims, err := a.validate(ctx, token, definition.GetJwt(), scopes)
+		if err == nil {
+			return claims, nil
+		}
+
+		// Prefer reporting a valid token with insufficient scopes, or one that couldn't be validated, over an invalid one
+		if lastErr == nil || err.Status == http.StatusForbidden || lastErr.Status == http.StatusUnauthorized {
+			lastErr = err
+		}
+	}
+
+	return nil, lastErr
+}
+
+func NewJwtAuthorizer(definitions *ApiDefinitions) *JwtAuthorizer {
+	return &JwtAuthorizer{
+		definitions: definitions,
+		client:      &http.Client{Timeout: time.Second * 10},
+		jwks:        make(map[string]*keyfunc.JWKS),
+	}
+}
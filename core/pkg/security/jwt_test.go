@@ -0,0 +1,196 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/security"
+)
+
+// newIssuer - starts an OpenID issuer serving the public half of the given key
+func newIssuer(key *rsa.PrivateKey) *httptest.Server {
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/jwks",
+		})
+	})
+
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			}},
+		})
+	})
+
+	return srv
+}
+
+func signToken(key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test"
+
+	signed, err := token.SignedString(key)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return signed
+}
+
+var _ = Describe("JwtAuthorizer", func() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var issuer *httptest.Server
+	var authorizer *security.JwtAuthorizer
+
+	BeforeEach(func() {
+		issuer = newIssuer(key)
+
+		apis := security.NewApiDefinitions()
+		apis.Declare("main", &v1.ApiResource{
+			SecurityDefinitions: map[string]*v1.ApiSecurityDefinition{
+				"user": {
+					Definition: &v1.ApiSecurityDefinition_Jwt{
+						Jwt: &v1.ApiSecurityDefinitionJwt{
+							Issuer:    issuer.URL,
+							Audiences: []string{"test-audience"},
+						},
+					},
+				},
+			},
+			Security: map[string]*v1.ApiScopes{
+				"user": {Scopes: []string{}},
+			},
+		})
+
+		authorizer = security.NewJwtAuthorizer(apis)
+	})
+
+	AfterEach(func() {
+		issuer.Close()
+	})
+
+	validClaims := func(scope string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   "test-audience",
+			"sub":   "user-1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": scope,
+		}
+	}
+
+	When("the api has not been declared", func() {
+		It("should allow the request", func() {
+			claims, err := authorizer.Authorize(context.TODO(), "unknown", nil, map[string][]string{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claims).To(BeNil())
+		})
+	})
+
+	When("no bearer token is provided", func() {
+		It("should return a 401", func() {
+			_, err := authorizer.Authorize(context.TODO(), "main", nil, map[string][]string{})
+
+			authErr := &security.AuthError{}
+			Expect(err).To(BeAssignableToTypeOf(authErr))
+			Expect(err.(*security.AuthError).StatusCode()).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("the token has the wrong audience", func() {
+		It("should return a 401", func() {
+			claims := validClaims("")
+			claims["aud"] = "other-audience"
+
+			_, err := authorizer.Authorize(context.TODO(), "main", nil, map[string][]string{
+				"Authorization": {"Bearer " + signToken(key, claims)},
+			})
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.(*security.AuthError).StatusCode()).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("a valid token is provided for the api's root security", func() {
+		It("should return the token claims", func() {
+			claims, err := authorizer.Authorize(context.TODO(), "main", nil, map[string][]string{
+				"Authorization": {"Bearer " + signToken(key, validClaims(""))},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claims["sub"]).To(Equal("user-1"))
+		})
+	})
+
+	When("the route requires a scope the token does not have", func() {
+		It("should return a 403", func() {
+			_, err := authorizer.Authorize(context.TODO(), "main", map[string][]string{"user": {"write"}}, map[string][]string{
+				"Authorization": {"Bearer " + signToken(key, validClaims("read"))},
+			})
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.(*security.AuthError).StatusCode()).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	When("the issuer's signing keys can't be retrieved", func() {
+		It("should return a 503 without the details of the failure", func() {
+			issuer.Close()
+
+			_, err := authorizer.Authorize(context.TODO(), "main", nil, map[string][]string{
+				"Authorization": {"Bearer " + signToken(key, validClaims(""))},
+			})
+
+			Expect(err).Should(HaveOccurred())
+			Expect(err.(*security.AuthError).StatusCode()).To(Equal(http.StatusServiceUnavailable))
+			Expect(err.Error()).ToNot(ContainSubstring(issuer.URL))
+		})
+	})
+
+	When("the route requires a scope the token has", func() {
+		It("should return the token claims", func() {
+			claims, err := authorizer.Authorize(context.TODO(), "main", map[string][]string{"user": {"write"}}, map[string][]string{
+				"Authorization": {"Bearer " + signToken(key, validClaims("read write"))},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claims["scope"]).To(Equal("read write"))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ConsoleExporter - Writes spans as JSON lines, for local debugging
+type ConsoleExporter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	stopped bool
+}
+
+var _ sdktrace.SpanExporter = &ConsoleExporter{}
+
+type consoleSpan struct {
+	Name         string            `json:"name"`
+	TraceID      string            `json:"traceId"`
+	SpanID       string            `json:"spanId"`
+	ParentSpanID string            `json:"parentSpanId,omitempty"`
+	Kind         string            `json:"kind"`
+	Start        time.Time         `json:"start"`
+	Duration     string            `json:"duration"`
+	Status       string            `json:"status"`
+	Description  string            `json:"description,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+func (e *ConsoleExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stopped {
+		return nil
+	}
+
+	for _, s := range spans {
+		cs := consoleSpan{
+			Name:        s.Name(),
+			TraceID:     s.SpanContext().TraceID().String(),
+			SpanID:      s.SpanContext().SpanID().String(),
+			Kind:        s.SpanKind().String(),
+			Start:       s.StartTime(),
+			Duration:    s.EndTime().Sub(s.StartTime()).String(),
+			Status:      s.Status().Code.String(),
+			Description: s.Status().Description,
+		}
+
+		if s.Parent().IsValid() {
+			cs.ParentSpanID = s.Parent().SpanID().String()
+		}
+
+		if attrs := s.Attributes(); len(attrs) > 0 {
+			cs.Attributes = make(map[string]string, len(attrs))
+			for _, kv := range attrs {
+				cs.Attributes[string(kv.Key)] = kv.Value.Emit()
+			}
+		}
+
+		if err := e.encoder.Encode(cs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *ConsoleExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.stopped = true
+
+	return nil
+}
+
+// NewConsoleExporter - creates an exporter writing spans to the given writer
+func NewConsoleExporter(w io.Writer) *ConsoleExporter {
+	return &ConsoleExporter{
+		encoder: json.NewEncoder(w),
+	}
+}
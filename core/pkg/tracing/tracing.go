@@ -0,0 +1,150 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/nitrictech/nitric/core/pkg/span"
+	"github.com/nitrictech/nitric/core/pkg/utils"
+)
+
+// Exporter - where the membrane sends its traces
+type Exporter string
+
+const (
+	// ExporterCollector - export to the otelcol pre-process launched by the membrane
+	ExporterCollector Exporter = "otelcol"
+	// ExporterOTLP - export directly to the OTLP endpoint configured with the standard OTEL_EXPORTER_OTLP_* variables
+	ExporterOTLP Exporter = "otlp"
+	// ExporterConsole - write spans to stdout, for local debugging
+	ExporterConsole Exporter = "console"
+	// ExporterNone - disable tracing
+	ExporterNone Exporter = "none"
+)
+
+// MembraneVersionKey - the resource attribute identifying the membrane version that produced the telemetry
+const MembraneVersionKey = attribute.Key("nitric.membrane.version")
+
+// Direct - returns true if the exporter doesn't need the otelcol pre-process
+func (e Exporter) Direct() bool {
+	return e == ExporterOTLP || e == ExporterConsole
+}
+
+func ExporterFromString(exporter string) (Exporter, error) {
+	switch e := Exporter(strings.ToLower(exporter)); e {
+	case ExporterCollector, ExporterOTLP, ExporterConsole, ExporterNone:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unknown trace exporter %s, expected one of %s, %s, %s or %s", exporter, ExporterCollector, ExporterOTLP, ExporterConsole, ExporterNone)
+	}
+}
+
+// ExporterFromEnv - the exporter selected by NITRIC_TRACE_EXPORTER, defaulting to the otelcol pre-process
+func ExporterFromEnv() (Exporter, error) {
+	return ExporterFromString(utils.GetEnv("NITRIC_TRACE_EXPORTER", string(ExporterCollector)))
+}
+
+// NewSpanExporter - creates the span exporter selected by NITRIC_TRACE_EXPORTER.
+// Direct OTLP export uses gRPC unless OTEL_EXPORTER_OTLP_PROTOCOL is http/protobuf.
+func NewSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	exporter, err := ExporterFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	switch exporter {
+	case ExporterCollector:
+		return otlptracegrpc.New(ctx, otlptracegrpc.WithInsecure())
+	case ExporterOTLP:
+		protocol := utils.GetEnv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", utils.GetEnv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc"))
+
+		switch protocol {
+		case "grpc":
+			return otlptracegrpc.New(ctx)
+		case "http/protobuf":
+			return otlptracehttp.New(ctx)
+		default:
+			return nil, fmt.Errorf("unsupported OTLP protocol %s, expected grpc or http/protobuf", protocol)
+		}
+	case ExporterConsole:
+		return NewConsoleExporter(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("tracing is disabled")
+	}
+}
+
+// Sampler - samples the percentage of new traces set by NITRIC_TRACE_SAMPLE_PERCENT, following the parent's decision otherwise
+func Sampler() (sdktrace.Sampler, error) {
+	rate, err := utils.PercentFromIntString(utils.GetEnv("NITRIC_TRACE_SAMPLE_PERCENT", "10"))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "NITRIC_TRACE_SAMPLE_PERCENT should be an int")
+	}
+
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(rate)), nil
+}
+
+// ResourceAttributes - the attributes identifying the function, its stack and the membrane in exported telemetry
+func ResourceAttributes(functionName string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.ServiceNameKey.String(functionName),
+		semconv.ServiceNamespaceKey.String(utils.GetEnv("NITRIC_STACK", "")),
+		MembraneVersionKey.String(span.MembraneVersion),
+	}
+}
+
+// NewTracerProvider - creates a provider for runtimes without their own, exporting to the exporter selected by env.
+// The standard OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES variables override the default resource attributes.
+func NewTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	exp, err := NewSpanExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(ResourceAttributes(span.FunctionName)...),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler, err := Sampler()
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+	), nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	Context("ExporterFromString", func() {
+		When("the exporter is known", func() {
+			It("should return the exporter", func() {
+				exporter, err := tracing.ExporterFromString("OTLP")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(exporter).To(Equal(tracing.ExporterOTLP))
+				Expect(exporter.Direct()).To(BeTrue())
+			})
+		})
+
+		When("the exporter is unknown", func() {
+			It("should return an error", func() {
+				_, err := tracing.ExporterFromString("jaeger")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("ConsoleExporter", func() {
+		When("a span ends", func() {
+			buf := &bytes.Buffer{}
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(tracing.NewConsoleExporter(buf)))
+
+			It("should write the span as json", func() {
+				_, s := tp.Tracer("test").Start(context.TODO(), "Trigger")
+				s.End()
+
+				out := map[string]interface{}{}
+				Expect(json.Unmarshal(buf.Bytes(), &out)).To(Succeed())
+				Expect(out["name"]).To(Equal("Trigger"))
+				Expect(out["traceId"]).To(Equal(s.SpanContext().TraceID().String()))
+			})
+		})
+	})
+
+	Context("NewSpanExporter", func() {
+		When("exporting directly over OTLP/HTTP", func() {
+			requests := make(chan *http.Request, 1)
+			bodies := make(chan []byte, 1)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				requests <- r
+				bodies <- body
+			}))
+
+			It("should post the spans to the configured endpoint", func() {
+				os.Setenv("NITRIC_TRACE_EXPORTER", "otlp")
+				os.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf")
+				os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", srv.URL)
+				os.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "x-api-key=secret")
+				defer func() {
+					os.Unsetenv("NITRIC_TRACE_EXPORTER")
+					os.Unsetenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+					os.Unsetenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+					os.Unsetenv("OTEL_EXPORTER_OTLP_HEADERS")
+					srv.Close()
+				}()
+
+				exp, err := tracing.NewSpanExporter(context.TODO())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+				_, s := tp.Tracer("test").Start(context.TODO(), "Trigger")
+				s.End()
+
+				r := <-requests
+				Expect(r.URL.Path).To(Equal("/v1/traces"))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/x-protobuf"))
+				Expect(r.Header.Get("x-api-key")).To(Equal("secret"))
+
+				req := &coltracepb.ExportTraceServiceRequest{}
+				Expect(proto.Unmarshal(<-bodies, req)).To(Succeed())
+				Expect(req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name).To(Equal("Trigger"))
+			})
+		})
+
+		When("tracing is disabled", func() {
+			It("should return an error", func() {
+				os.Setenv("NITRIC_TRACE_EXPORTER", "none")
+				defer os.Unsetenv("NITRIC_TRACE_EXPORTER")
+
+				_, err := tracing.NewSpanExporter(context.TODO())
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -14,12 +14,20 @@
 
 package triggers
 
+import "time"
+
 // Event - A nitric event that has come from a trigger source
 type Event struct {
-	ID         string
-	Topic      string
-	Payload    []byte
-	Attributes map[string]string
+	ID    string
+	Topic string
+	// The type of the payload, as set by the publisher
+	PayloadType string
+	Payload     []byte
+	Attributes  map[string]string
+	// The time the event was published, zero if unknown
+	PublishTime time.Time
+	// The delivery attempt of the event starting at 1, 0 if the source does not report attempts
+	DeliveryAttempt int
 }
 
 func (*Event) GetTriggerType() TriggerType {
@@ -15,6 +15,7 @@
 package triggers
 
 import (
+	"io"
 	"strings"
 
 	"github.com/valyala/fasthttp"
@@ -25,6 +26,8 @@ type HttpRequest struct {
 	Header map[string][]string
 	// The original body stream
 	Body []byte
+	// The streamed body, used in place of Body when set
+	BodyStream io.Reader
 	// The original method
 	Method string
 	// The original path
@@ -35,6 +38,14 @@ type HttpRequest struct {
 	Query map[string][]string
 	// Path parameters
 	Params map[string]string
+	// Claims of the validated bearer token, for secured routes
+	Claims map[string]interface{}
+	// The API the request was received on, an empty API matches routes of any API
+	Api string
+	// Security requirements of the matched route, mapping security definition names to required scopes
+	Security map[string][]string
+	// Whether security was explicitly disabled for the matched route
+	SecurityDisabled bool
 }
 
 func (*HttpRequest) GetTriggerType() TriggerType {
@@ -60,6 +71,27 @@ func HttpHeaders(rh *fasthttp.RequestHeader) map[string][]string {
 
 // FromHttpRequest (constructs a HttpRequest source type from a HttpRequest)
 func FromHttpRequest(rc *fasthttp.RequestCtx) *HttpRequest {
+	req := fromHttpRequestHeader(rc)
+	req.Body = rc.Request.Body()
+
+	return req
+}
+
+// FromStreamingHttpRequest (constructs a HttpRequest with a streamed body, for servers with StreamRequestBody enabled)
+// Falls back to the buffered body if it has already been read.
+func FromStreamingHttpRequest(rc *fasthttp.RequestCtx) *HttpRequest {
+	req := fromHttpRequestHeader(rc)
+
+	if rc.Request.IsBodyStream() {
+		req.BodyStream = rc.RequestBodyStream()
+	} else {
+		req.Body = rc.Request.Body()
+	}
+
+	return req
+}
+
+func fromHttpRequestHeader(rc *fasthttp.RequestCtx) *HttpRequest {
 	headerCopy := HttpHeaders(&rc.Request.Header)
 	queryArgs := make(map[string][]string)
 
@@ -75,7 +107,6 @@ func FromHttpRequest(rc *fasthttp.RequestCtx) *HttpRequest {
 
 	return &HttpRequest{
 		Header: headerCopy,
-		Body:   rc.Request.Body(),
 		Method: string(rc.Method()),
 		URL:    rc.URI().String(),
 		Path:   string(rc.URI().PathOriginal()),
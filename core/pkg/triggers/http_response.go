@@ -16,6 +16,7 @@ package triggers
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/valyala/fasthttp"
 
@@ -28,6 +29,8 @@ type HttpResponse struct {
 	Header *fasthttp.ResponseHeader
 	// The original body stream
 	Body []byte
+	// The streamed body, used in place of Body when set
+	BodyStream io.Reader
 	// The original method
 	StatusCode int
 }
@@ -0,0 +1,33 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import "time"
+
+// Schedule - A firing of a schedule
+type Schedule struct {
+	// The key the schedule was declared with
+	Key string
+	// The time the schedule was due to fire
+	ScheduledTime time.Time
+	// The time the schedule fired, later than the scheduled time by any jitter or delay
+	FireTime time.Time
+	// The rate or cron expression the schedule was declared with, set by the worker handling it if unknown to the scheduler
+	Cadence string
+}
+
+func (*Schedule) GetTriggerType() TriggerType {
+	return TriggerType_Schedule
+}
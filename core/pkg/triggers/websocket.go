@@ -0,0 +1,46 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+// WebsocketEvent - The lifecycle event of a websocket connection
+type WebsocketEvent int
+
+const (
+	WebsocketEvent_Connect WebsocketEvent = iota
+	WebsocketEvent_Disconnect
+	WebsocketEvent_Message
+)
+
+func (e WebsocketEvent) String() string {
+	return []string{"CONNECT", "DISCONNECT", "MESSAGE"}[e]
+}
+
+// WebsocketMessage - Captures a connection event or message received on a websocket
+type WebsocketMessage struct {
+	// The nitric name of the socket
+	Socket string
+	// The connection event
+	Event WebsocketEvent
+	// The ID of the connection the event occurred on
+	ConnectionID string
+	// Query parameters of the connection request
+	Query map[string][]string
+	// The message body, for message events
+	Body []byte
+}
+
+func (*WebsocketMessage) GetTriggerType() TriggerType {
+	return TriggerType_Websocket
+}
@@ -0,0 +1,29 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "strings"
+
+const unixAddressPrefix = "unix://"
+
+// SplitAddress - returns the network and address to use with net.Listen and net.Dial.
+// e.g - SplitAddress("unix:///tmp/nitric.sock") == ("unix", "/tmp/nitric.sock"), SplitAddress("127.0.0.1:50051") == ("tcp", "127.0.0.1:50051")
+func SplitAddress(address string) (string, string) {
+	if strings.HasPrefix(address, unixAddressPrefix) {
+		return "unix", strings.TrimPrefix(address, unixAddressPrefix)
+	}
+
+	return "tcp", address
+}
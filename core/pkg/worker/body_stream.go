@@ -0,0 +1,168 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+)
+
+// bodyChunkSize - the maximum size of the body chunks streamed to workers
+const bodyChunkSize = 64 * 1024
+
+// bodyBufferChunks - the number of chunks buffered for a response body, before it is aborted for not being read
+const bodyBufferChunks = 64
+
+// errBodyBufferFull - the error a response body is aborted with when its reader falls too far behind the worker
+var errBodyBufferFull = errors.New("response body buffer is full, the client is not reading it")
+
+// responseBody - a response body streamed from a worker in chunks
+type responseBody struct {
+	chunks chan []byte
+	reader *io.PipeReader
+	// Closed once the reader is closed, after which chunks are dropped
+	readerClosed chan struct{}
+	closeOnce    sync.Once
+	// Held while writing to or closing the queue, as the body may be aborted while a chunk is being written
+	lock   sync.Mutex
+	closed bool
+	// The error the body was aborted with, if any
+	err error
+}
+
+func newResponseBody() *responseBody {
+	pr, pw := io.Pipe()
+
+	body := &responseBody{
+		chunks:       make(chan []byte, bodyBufferChunks),
+		reader:       pr,
+		readerClosed: make(chan struct{}),
+	}
+
+	go func() {
+		for data := range body.chunks {
+			// Writes fail immediately once the reader is closed, so the queue keeps draining
+			_, _ = pw.Write(data)
+		}
+
+		_ = pw.CloseWithError(body.err)
+	}()
+
+	return body
+}
+
+// write - queues a chunk to be read from the body, dropping it if the reader has been closed or the body has ended.
+// Never blocks, as the stream the chunks arrive on is shared with the worker's other triggers.
+// Returns false if the chunk could not be queued because the reader has fallen too far behind.
+func (b *responseBody) write(data []byte) bool {
+	if len(data) == 0 {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.closed {
+		return true
+	}
+
+	select {
+	case <-b.readerClosed:
+		return true
+	default:
+	}
+
+	select {
+	case b.chunks <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// closeReader - stops reading the body, dropping any chunks that follow
+func (b *responseBody) closeReader() {
+	b.closeOnce.Do(func() {
+		close(b.readerClosed)
+		_ = b.reader.Close()
+	})
+}
+
+// bodyReader - reads a streamed response body after the data sent with its response
+type bodyReader struct {
+	io.Reader
+	body *responseBody
+}
+
+// Close - stops reading the body, e.g. when the client has gone away
+func (r *bodyReader) Close() error {
+	r.body.closeReader()
+
+	return nil
+}
+
+// readCloser - returns a reader of the body, following the given data sent with its response
+func (b *responseBody) readCloser(data []byte) io.ReadCloser {
+	if b == nil {
+		return io.NopCloser(bytes.NewReader(data))
+	}
+
+	return &bodyReader{
+		Reader: io.MultiReader(bytes.NewReader(data), b.reader),
+		body:   b,
+	}
+}
+
+// close - ends the body, readers will receive the given error after the queued chunks, or EOF if it is nil.
+// Only the first close takes effect, and it is safe to call while a chunk is being written.
+func (b *responseBody) close(err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+	b.err = err
+	close(b.chunks)
+}
+
+// readChunks - reads the body in chunks, calling send for each until the body ends or send fails
+func readChunks(body io.Reader, send func(data []byte, end bool) error) error {
+	buf := make([]byte, bodyChunkSize)
+
+	for {
+		n, err := io.ReadFull(body, buf)
+
+		end := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !end {
+			return err
+		}
+
+		data := make([]byte, n)
+		copy(data, buf[:n])
+
+		if err := send(data, end); err != nil {
+			return err
+		}
+
+		if end {
+			return nil
+		}
+	}
+}
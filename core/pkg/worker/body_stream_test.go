@@ -0,0 +1,70 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("responseBody", func() {
+	When("the body is read", func() {
+		It("should return the response data followed by the streamed chunks", func() {
+			body := newResponseBody()
+			reader := body.readCloser([]byte("hello "))
+
+			body.write([]byte("world"))
+			body.close(nil)
+
+			data, err := io.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("hello world"))
+		})
+	})
+
+	When("the reader is closed before the body is read", func() {
+		It("should drop chunks without blocking the writer", func() {
+			body := newResponseBody()
+			reader := body.readCloser(nil)
+			Expect(reader.Close()).To(Succeed())
+
+			written := make(chan struct{})
+			go func() {
+				for i := 0; i < bodyBufferChunks*2; i++ {
+					body.write([]byte("chunk"))
+				}
+				body.close(nil)
+				close(written)
+			}()
+
+			Eventually(written, time.Second).Should(BeClosed())
+		})
+	})
+
+	When("the reader falls behind", func() {
+		It("should refuse chunks once the buffer is full, rather than blocking the writer", func() {
+			body := newResponseBody()
+			reader := body.readCloser(nil)
+			defer reader.Close()
+
+			Eventually(func() bool {
+				return body.write([]byte("chunk"))
+			}, time.Second).Should(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,95 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOverloaded - returned when a worker is handling its maximum concurrent triggers
+// and no slot became free within the concurrency wait. The trigger may be retried.
+var ErrOverloaded = errors.New("worker is handling its maximum concurrent triggers")
+
+// CapacityReporter - implemented by workers and adapters that limit the triggers they handle concurrently
+type CapacityReporter interface {
+	// HasCapacity - returns true if a trigger can be handled without waiting
+	HasCapacity() bool
+}
+
+// concurrencyLimit - a semaphore bounding the triggers an adapter handles concurrently
+type concurrencyLimit struct {
+	slots chan struct{}
+	// The time to wait for a slot before giving up
+	wait time.Duration
+}
+
+// newConcurrencyLimit - returns a limit of max concurrent triggers, or nil if max is less than 1
+func newConcurrencyLimit(max int, wait time.Duration) *concurrencyLimit {
+	if max < 1 {
+		return nil
+	}
+
+	return &concurrencyLimit{
+		slots: make(chan struct{}, max),
+		wait:  wait,
+	}
+}
+
+// acquire - takes a slot, waiting up to the limit's wait for one to be released.
+// Returns ErrOverloaded if none was, or the context's error if it finished first.
+// A nil limit is unlimited.
+func (l *concurrencyLimit) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if l.wait <= 0 {
+		return ErrOverloaded
+	}
+
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrOverloaded
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release - frees a slot taken by acquire
+func (l *concurrencyLimit) release() {
+	if l == nil {
+		return
+	}
+
+	<-l.slots
+}
+
+// hasCapacity - returns true if a slot is free
+func (l *concurrencyLimit) hasCapacity() bool {
+	return l == nil || len(l.slots) < cap(l.slots)
+}
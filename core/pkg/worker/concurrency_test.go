@@ -0,0 +1,167 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_nitric "github.com/nitrictech/nitric/core/mocks/nitric"
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// newSaturatedRouteWorker - returns a route worker whose adapter is handling its maximum concurrent triggers
+func newSaturatedRouteWorker() *RouteWorker {
+	limit := newConcurrencyLimit(1, 0)
+	Expect(limit.acquire(context.TODO())).To(Succeed())
+
+	return NewRouteWorker(&GrpcAdapter{
+		responseQueueLock: &sync.Mutex{},
+		limit:             limit,
+	}, &RouteWorkerOptions{
+		Path:    "/test",
+		Methods: []string{"GET"},
+	})
+}
+
+var _ = Describe("Concurrency", func() {
+	Context("GrpcAdapter with a maximum concurrency", func() {
+		When("the worker is handling its maximum concurrent triggers", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{
+				MaxConcurrency:  1,
+				ConcurrencyWait: 10 * time.Millisecond,
+			})
+
+			It("should reject triggers that wait too long for a slot", func() {
+				sent := make(chan string, 1)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent <- msg.GetId()
+					return nil
+				}).Times(1)
+
+				ctx, cancel := context.WithCancel(context.TODO())
+				first := make(chan error, 1)
+				go func() {
+					first <- wkr.HandleEvent(ctx, &triggers.Event{})
+				}()
+				Eventually(sent).Should(Receive())
+
+				By("reporting no capacity")
+				Expect(wkr.HasCapacity()).To(BeFalse())
+
+				By("returning ErrOverloaded without sending the trigger")
+				Expect(wkr.HandleEvent(context.TODO(), &triggers.Event{})).To(MatchError(ErrOverloaded))
+
+				_, err := wkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{})
+				Expect(err).To(MatchError(ErrOverloaded))
+
+				By("freeing the slot once the first trigger finishes")
+				stream.EXPECT().Send(gomock.Any()).Return(nil)
+				cancel()
+				Eventually(first).Should(Receive())
+				Expect(wkr.HasCapacity()).To(BeTrue())
+			})
+		})
+
+		When("a slot is freed within the concurrency wait", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{
+				MaxConcurrency:  1,
+				ConcurrencyWait: time.Second,
+			})
+
+			It("should send the queued trigger", func() {
+				sent := make(chan string, 2)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent <- msg.GetId()
+					return nil
+				}).Times(2)
+
+				respond := func(ID string) {
+					ch, err := wkr.resolveTicket(ID)
+					Expect(err).ShouldNot(HaveOccurred())
+					ch <- &v1.TriggerResponse{
+						Context: &v1.TriggerResponse_Topic{
+							Topic: &v1.TopicResponseContext{Success: true},
+						},
+					}
+				}
+
+				results := make(chan error, 2)
+				for i := 0; i < 2; i++ {
+					go func() {
+						results <- wkr.HandleEvent(context.TODO(), &triggers.Event{})
+					}()
+				}
+
+				var first string
+				Eventually(sent).Should(Receive(&first))
+				Consistently(sent, 20*time.Millisecond).ShouldNot(Receive())
+
+				respond(first)
+
+				var second string
+				Eventually(sent).Should(Receive(&second))
+				respond(second)
+
+				Eventually(results).Should(Receive(BeNil()))
+				Eventually(results).Should(Receive(BeNil()))
+			})
+		})
+	})
+
+	Context("ProcessPool", func() {
+		When("a worker for the trigger is handling its maximum concurrent triggers", func() {
+			saturated := newSaturatedRouteWorker()
+			available := newRouteWorkerWithInFlight(3)
+
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10, Strategy: &FirstStrategy{}})
+			Expect(pp.AddWorker(saturated)).To(Succeed())
+			Expect(pp.AddWorker(available)).To(Succeed())
+
+			It("should route the trigger to a worker with capacity", func() {
+				wrkr, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr).To(Equal(available))
+			})
+		})
+
+		When("all workers for the trigger are handling their maximum concurrent triggers", func() {
+			saturated := newSaturatedRouteWorker()
+
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+			Expect(pp.AddWorker(saturated)).To(Succeed())
+
+			It("should still select a worker for the trigger to wait on", func() {
+				wrkr, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr).To(Equal(saturated))
+			})
+		})
+	})
+})
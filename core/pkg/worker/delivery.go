@@ -0,0 +1,82 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+)
+
+// DeliveryMode - How an event is delivered to the workers subscribed to its topic
+type DeliveryMode string
+
+const (
+	// DeliveryOneOf - Each subscription receives the event once, delivered to one of the workers registered for it.
+	// Workers subscribed to the same topic are treated as replicas of one subscription, so this suits pools whose
+	// workers are all copies of the same process, e.g. replicas of the child process started by the membrane
+	DeliveryOneOf DeliveryMode = "one-of"
+	// DeliveryAllOf - Every worker subscribed to the topic receives the event, the default
+	DeliveryAllOf DeliveryMode = "all-of"
+)
+
+// DeliveryModeFromString - returns the named delivery mode
+func DeliveryModeFromString(name string) (DeliveryMode, error) {
+	switch mode := DeliveryMode(name); mode {
+	case DeliveryOneOf, DeliveryAllOf:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid worker delivery mode %s, supported modes are: %s, %s", name, DeliveryOneOf, DeliveryAllOf)
+	}
+}
+
+// subscriptionKey - identifies the subscription a worker was registered for, workers with the same key are interchangeable.
+// Returns an empty string for workers that are not subscriptions.
+func subscriptionKey(w Worker) string {
+	switch bw := BaseWorker(w).(type) {
+	case *SubscriptionWorker:
+		return "subscription:" + bw.Topic()
+	case *ScheduleWorker:
+		return "schedule:" + bw.Key()
+	default:
+		return ""
+	}
+}
+
+// oneOfSubscribers - narrows the workers to one worker per subscription, selected by strategy.
+// Workers that are not subscriptions are kept. Must be called with the worker lock held.
+func (p *ProcessPool) oneOfSubscribers(opts *GetWorkerOptions, workers []Worker) []Worker {
+	subscribers := map[string][]Worker{}
+	keys := []string{}
+	selected := make([]Worker, 0, len(workers))
+
+	for _, w := range workers {
+		key := subscriptionKey(w)
+		if key == "" {
+			selected = append(selected, w)
+			continue
+		}
+
+		if _, ok := subscribers[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		subscribers[key] = append(subscribers[key], w)
+	}
+
+	for _, key := range keys {
+		selected = append(selected, p.selectWorker(opts, subscribers[key]))
+	}
+
+	return selected
+}
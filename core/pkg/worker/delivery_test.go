@@ -0,0 +1,57 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+var _ = Describe("DeliveryMode", func() {
+	When("getting the subscribers of an event", func() {
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+		first := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "orders"})
+		second := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "orders"})
+		other := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "payments"})
+		Expect(pp.AddWorker(first)).To(Succeed())
+		Expect(pp.AddWorker(second)).To(Succeed())
+		Expect(pp.AddWorker(other)).To(Succeed())
+
+		evt := &triggers.Event{Topic: "orders"}
+
+		It("should return every worker by default", func() {
+			Expect(pp.GetWorkers(&GetWorkerOptions{Event: evt})).To(ConsistOf(first, second))
+		})
+
+		It("should return one worker per subscription when one-of delivery is requested", func() {
+			Expect(pp.GetWorkers(&GetWorkerOptions{Event: evt, Delivery: DeliveryOneOf})).To(HaveLen(1))
+		})
+	})
+
+	When("parsing a delivery mode name", func() {
+		It("should return the named mode", func() {
+			mode, err := DeliveryModeFromString("all-of")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode).To(Equal(DeliveryAllOf))
+		})
+
+		It("should reject unknown modes", func() {
+			_, err := DeliveryModeFromString("some-of")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,123 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// DispatchError - The failures of an event dispatched to multiple workers
+type DispatchError struct {
+	Topic  string
+	Errors []error
+}
+
+func (e *DispatchError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("%d of the workers for topic %s failed to handle the event: %s", len(e.Errors), e.Topic, strings.Join(msgs, "; "))
+}
+
+// DispatchEvent - Delivers the event to the subscribers of its topic concurrently, waiting for all of them to finish.
+// In the one-of delivery mode each subscription receives the event once, even if replicas registered it.
+// If the event has no subscribers it is delivered to a single worker able to handle it, e.g. a proxied http worker.
+// Returns a DispatchError if any delivery failed, in which case the event should be nacked for redelivery.
+func DispatchEvent(ctx context.Context, pool WorkerPool, event *triggers.Event) error {
+	workers := pool.GetWorkers(&GetWorkerOptions{
+		Event:  event,
+		Filter: isSpecialised,
+	})
+
+	if len(workers) == 0 {
+		wrkr, err := pool.GetWorker(&GetWorkerOptions{
+			Event: event,
+		})
+		if err != nil {
+			return fmt.Errorf("no workers available for topic %s: %w", event.Topic, err)
+		}
+
+		workers = []Worker{wrkr}
+	}
+
+	errs := make([]error, len(workers))
+	wg := sync.WaitGroup{}
+
+	for i, w := range workers {
+		wg.Add(1)
+
+		go func(i int, w Worker) {
+			defer wg.Done()
+			errs[i] = w.HandleEvent(ctx, event)
+		}(i, w)
+	}
+
+	wg.Wait()
+
+	dispatchErr := &DispatchError{Topic: event.Topic}
+	for _, err := range errs {
+		if err != nil {
+			dispatchErr.Errors = append(dispatchErr.Errors, err)
+		}
+	}
+
+	if len(dispatchErr.Errors) > 0 {
+		return dispatchErr
+	}
+
+	return nil
+}
+
+// DispatchWebsocketMessage - Delivers the websocket message to a single worker registered for its socket and event.
+// An error returned for a connect event means the connection should be rejected.
+func DispatchWebsocketMessage(ctx context.Context, pool WorkerPool, msg *triggers.WebsocketMessage) error {
+	wrkr, err := pool.GetWorker(&GetWorkerOptions{
+		Websocket: msg,
+	})
+	if err != nil {
+		return fmt.Errorf("no workers available for socket %s %s event: %w", msg.Socket, msg.Event, err)
+	}
+
+	wh, ok := wrkr.(WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker for socket %s cannot handle websocket messages", msg.Socket)
+	}
+
+	return wh.HandleWebsocketMessage(ctx, msg)
+}
+
+// DispatchSchedule - Delivers the firing of a schedule to a single worker registered for its key
+func DispatchSchedule(ctx context.Context, pool WorkerPool, schedule *triggers.Schedule) error {
+	wrkr, err := pool.GetWorker(&GetWorkerOptions{
+		Schedule: schedule,
+	})
+	if err != nil {
+		return fmt.Errorf("no workers available for schedule %s: %w", schedule.Key, err)
+	}
+
+	sh, ok := wrkr.(ScheduleHandler)
+	if !ok {
+		return fmt.Errorf("worker for schedule %s cannot handle schedules", schedule.Key)
+	}
+
+	return sh.HandleSchedule(ctx, schedule)
+}
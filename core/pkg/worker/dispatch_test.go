@@ -0,0 +1,103 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock "github.com/nitrictech/nitric/core/mocks/worker"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+var _ = Describe("DispatchEvent", func() {
+	When("the topic has multiple subscribers", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		first := mock.NewMockAdapter(ctrl)
+		second := mock.NewMockAdapter(ctrl)
+		fallback := mock.NewMockAdapter(ctrl)
+
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+		Expect(pp.AddWorker(NewSubscriptionWorker(first, &SubscriptionWorkerOptions{Topic: "orders"}))).To(Succeed())
+		Expect(pp.AddWorker(InstrumentedWorkerFn(NewSubscriptionWorker(second, &SubscriptionWorkerOptions{Topic: "orders"})))).To(Succeed())
+		Expect(pp.AddWorker(NewSubscriptionWorker(fallback, &SubscriptionWorkerOptions{Topic: "payments"}))).To(Succeed())
+		Expect(pp.AddWorker(NewFaasWorker(fallback))).To(Succeed())
+
+		evt := &triggers.Event{ID: "1", Topic: "orders"}
+
+		It("should deliver to every subscriber and aggregate their failures", func() {
+			first.EXPECT().HandleEvent(gomock.Any(), evt).Return(nil).Times(1)
+			second.EXPECT().HandleEvent(gomock.Any(), evt).Return(fmt.Errorf("subscriber failed")).Times(1)
+
+			err := DispatchEvent(context.TODO(), pp, evt)
+
+			var dispatchErr *DispatchError
+			Expect(errors.As(err, &dispatchErr)).To(BeTrue())
+			Expect(dispatchErr.Errors).To(HaveLen(1))
+			Expect(err.Error()).To(ContainSubstring("subscriber failed"))
+		})
+	})
+
+	When("replicas register the same subscriptions", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		replicas := []*mock.MockAdapter{mock.NewMockAdapter(ctrl), mock.NewMockAdapter(ctrl)}
+		schedules := []*mock.MockAdapter{mock.NewMockAdapter(ctrl), mock.NewMockAdapter(ctrl)}
+
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10, Delivery: DeliveryOneOf})
+		for i := range replicas {
+			Expect(pp.AddWorker(NewSubscriptionWorker(replicas[i], &SubscriptionWorkerOptions{Topic: ScheduleKeyToTopicName("nightly")}))).To(Succeed())
+			Expect(pp.AddWorker(NewScheduleWorker(schedules[i], &ScheduleWorkerOptions{Key: "nightly"}))).To(Succeed())
+		}
+
+		evt := &triggers.Event{ID: "1", Topic: ScheduleKeyToTopicName("nightly")}
+
+		It("should deliver to one replica of each subscription", func() {
+			var delivered int32
+			count := func(context.Context, *triggers.Event) error {
+				atomic.AddInt32(&delivered, 1)
+				return nil
+			}
+
+			for _, a := range append(replicas, schedules...) {
+				a.EXPECT().HandleEvent(gomock.Any(), evt).DoAndReturn(count).MaxTimes(1)
+			}
+
+			Expect(DispatchEvent(context.TODO(), pp, evt)).To(Succeed())
+			Expect(atomic.LoadInt32(&delivered)).To(BeEquivalentTo(2))
+		})
+	})
+
+	When("the topic has no subscribers", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		adapter := mock.NewMockAdapter(ctrl)
+
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+		Expect(pp.AddWorker(NewFaasWorker(adapter))).To(Succeed())
+
+		evt := &triggers.Event{ID: "1", Topic: "orders"}
+
+		It("should deliver to a single generic worker", func() {
+			adapter.EXPECT().HandleEvent(gomock.Any(), evt).Return(nil).Times(1)
+
+			Expect(DispatchEvent(context.TODO(), pp, evt)).To(Succeed())
+		})
+	})
+})
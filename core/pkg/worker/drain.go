@@ -0,0 +1,72 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDraining - returned when a trigger is sent to a worker that is leaving the pool. The trigger may be retried.
+var ErrDraining = errors.New("worker is draining")
+
+// Drainer - implemented by workers and adapters that can finish their outstanding triggers before leaving the pool
+type Drainer interface {
+	// Drain - stops the worker accepting new triggers and asks it to shut down once its outstanding triggers are complete
+	Drain(reason string) error
+	// Draining - returns true if the worker is leaving the pool and should not be sent new triggers
+	Draining() bool
+}
+
+// workerDrainer - returns the drainer of the worker, if it supports draining
+func workerDrainer(w Worker) (Drainer, bool) {
+	for _, layer := range workerLayers(w) {
+		if d, ok := layer.(Drainer); ok {
+			return d, true
+		}
+	}
+
+	return nil, false
+}
+
+// isActive - returns false for workers that are draining
+func isActive(w Worker) bool {
+	d, ok := workerDrainer(w)
+
+	return !ok || !d.Draining()
+}
+
+// DrainWorkers - Drains every worker in the pool that supports draining, so they leave the pool once their outstanding triggers are complete
+func DrainWorkers(pool WorkerPool, reason string) error {
+	msgs := []string{}
+
+	for _, w := range pool.GetWorkers(&GetWorkerOptions{}) {
+		d, ok := workerDrainer(w)
+		if !ok || d.Draining() {
+			continue
+		}
+
+		if err := d.Drain(reason); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("could not drain %d workers: %s", len(msgs), strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
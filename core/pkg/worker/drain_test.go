@@ -0,0 +1,178 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_nitric "github.com/nitrictech/nitric/core/mocks/nitric"
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+func newDrainingRouteWorker() *RouteWorker {
+	return NewRouteWorker(&GrpcAdapter{
+		responseQueueLock: &sync.Mutex{},
+		draining:          true,
+	}, &RouteWorkerOptions{
+		Path:    "/test",
+		Methods: []string{"GET"},
+	})
+}
+
+var _ = Describe("Drain", func() {
+	Context("GrpcAdapter", func() {
+		When("the worker requests to shut down with an outstanding trigger", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			errChan := make(chan error)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should confirm the shutdown once the trigger is responded to", func() {
+				ID, respChan, err := wkr.newTicket()
+				Expect(err).ShouldNot(HaveOccurred())
+
+				respond := make(chan struct{})
+				closeStream := make(chan struct{})
+				sent := make(chan *v1.ServerMessage, 1)
+
+				gomock.InOrder(
+					stream.EXPECT().Recv().Return(&v1.ClientMessage{
+						Content: &v1.ClientMessage_ShutdownRequest{
+							ShutdownRequest: &v1.ShutdownRequest{},
+						},
+					}, nil),
+					stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+						<-respond
+						return &v1.ClientMessage{
+							Id: ID,
+							Content: &v1.ClientMessage_TriggerResponse{
+								TriggerResponse: &v1.TriggerResponse{},
+							},
+						}, nil
+					}),
+					stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+						<-closeStream
+						return nil, io.EOF
+					}),
+				)
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent <- msg
+					return nil
+				})
+
+				go wkr.Start(errChan)
+
+				By("no longer accepting triggers")
+				Eventually(wkr.Draining).Should(BeTrue())
+				Expect(wkr.HandleEvent(context.TODO(), &triggers.Event{})).To(MatchError(ErrDraining))
+
+				By("waiting for the outstanding response")
+				Consistently(sent, 20*time.Millisecond).ShouldNot(Receive())
+
+				close(respond)
+				Eventually(respChan).Should(Receive())
+
+				By("sending a shutdown response")
+				var msg *v1.ServerMessage
+				Eventually(sent).Should(Receive(&msg))
+				Expect(msg.GetShutdownResponse()).ToNot(BeNil())
+
+				close(closeStream)
+
+				Expect(<-errChan).To(Equal(io.EOF))
+			})
+		})
+
+		When("draining the worker", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should send a drain request and stop accepting triggers", func() {
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					Expect(msg.GetDrainRequest().GetReason()).To(Equal("stopping"))
+					return nil
+				})
+
+				Expect(wkr.Drain("stopping")).To(Succeed())
+				Expect(wkr.Draining()).To(BeTrue())
+
+				_, err := wkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{})
+				Expect(err).To(MatchError(ErrDraining))
+			})
+		})
+	})
+
+	Context("ProcessPool", func() {
+		When("a worker for the trigger is draining", func() {
+			draining := newDrainingRouteWorker()
+			active := newRouteWorkerWithInFlight(0)
+
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10, Strategy: &FirstStrategy{}})
+			Expect(pp.AddWorker(draining)).To(Succeed())
+			Expect(pp.AddWorker(active)).To(Succeed())
+
+			It("should route the trigger to an active worker", func() {
+				wrkr, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr).To(Equal(active))
+			})
+
+			It("should exclude the draining worker from all workers", func() {
+				Expect(pp.GetWorkers(&GetWorkerOptions{})).To(Equal([]Worker{active}))
+			})
+		})
+
+		When("all workers for the trigger are draining", func() {
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+			Expect(pp.AddWorker(newDrainingRouteWorker())).To(Succeed())
+
+			It("should return ErrDraining", func() {
+				_, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).To(MatchError(ErrDraining))
+			})
+		})
+	})
+
+	Context("DrainWorkers", func() {
+		When("the pool has workers that support draining", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			adapter := NewGrpcAdapter(stream, nil)
+
+			pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+			Expect(pp.AddWorker(NewFaasWorker(adapter))).To(Succeed())
+
+			It("should drain them", func() {
+				stream.EXPECT().Send(gomock.Any()).Return(nil)
+
+				Expect(DrainWorkers(pp, "stopping")).To(Succeed())
+				Expect(adapter.Draining()).To(BeTrue())
+			})
+		})
+	})
+})
@@ -18,40 +18,127 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/valyala/fasthttp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/span"
 	"github.com/nitrictech/nitric/core/pkg/triggers"
 )
 
 type GrpcAdapter struct {
 	stream v1.FaasService_TriggerStreamServer
+	// Serialises sends, as gRPC streams do not support concurrent sends
+	sendLock sync.Mutex
+	// The worker supports streamed http bodies
+	bodyStreaming bool
+	// The worker handles schedule triggers, otherwise schedules are sent to it as events
+	scheduleTriggers bool
 	// Response channels for this worker
 	responseQueueLock sync.Locker
 	responseQueue     map[string]chan *v1.TriggerResponse
+	// Response bodies being streamed from the worker, by message ID
+	responseBodies map[string]*responseBody
+	// Streamed response bodies, awaiting collection with their response
+	responseReaders map[string]*responseBody
+	// IDs of cancelled requests, whose late responses should be discarded, with when they were cancelled
+	cancelled map[string]time.Time
+	// The worker is leaving the pool and will not be sent new triggers
+	draining bool
+	// Pings are sent to the worker at this interval, heartbeats are disabled if 0
+	heartbeatInterval time.Duration
+	// The worker is unresponsive if no message has been received from it within this time
+	heartbeatTimeout time.Duration
+	// When the last message was received from the worker, in unix nanoseconds
+	lastSeen int64
+	// Set while a ping is being sent
+	pinging int32
+	// Ensures the exit of the worker is reported once
+	exitOnce sync.Once
+	// Closed when the worker exits, failing the triggers still waiting on it with exitErr
+	exited  chan struct{}
+	exitErr error
+	// Number of triggers currently being handled by this worker
+	inFlight int64
+	// Bounds the triggers sent to the worker concurrently, unlimited if nil
+	limit *concurrencyLimit
+	log   *logrus.Entry
 }
 
-var _ Adapter = &GrpcAdapter{}
+var (
+	_ Adapter                  = &GrpcAdapter{}
+	_ ScheduleHandler          = &GrpcAdapter{}
+	_ InFlightCounter          = &GrpcAdapter{}
+	_ CapacityReporter         = &GrpcAdapter{}
+	_ Drainer                  = &GrpcAdapter{}
+	_ ScheduleTriggerSupporter = &GrpcAdapter{}
+)
+
+// cancelledTTL - how long a late response to a cancelled request is expected for, before the request is forgotten
+const cancelledTTL = 5 * time.Minute
+
+// InFlight - returns the number of triggers this adapter is waiting on responses for
+func (s *GrpcAdapter) InFlight() int {
+	return int(atomic.LoadInt64(&s.inFlight))
+}
+
+// SupportsScheduleTriggers - returns true if the worker declared support for schedule triggers
+func (s *GrpcAdapter) SupportsScheduleTriggers() bool {
+	return s.scheduleTriggers
+}
+
+// HasCapacity - returns true if the worker is below its maximum concurrent triggers
+func (s *GrpcAdapter) HasCapacity() bool {
+	return s.limit.hasCapacity()
+}
+
+// logger - returns the adapter's logger, or the default logger for adapters not created with NewGrpcAdapter
+func (s *GrpcAdapter) logger() *logrus.Entry {
+	if s.log == nil {
+		return logger.Default()
+	}
+
+	return s.log
+}
+
+// requestLogger - returns a logger for the trigger request with the given ID, including the fields of the adapter's logger
+func (s *GrpcAdapter) requestLogger(ctx context.Context, ID string) *logrus.Entry {
+	log := logger.FromContext(ctx).WithFields(s.logger().Data)
+
+	if logger.RequestID(ctx) == "" {
+		log = log.WithField(logger.RequestIDKey, ID)
+	}
+
+	return log
+}
 
 // newTicket - Generates a request/response ID and response channel
-// for the requesting thread to wait on
-func (s *GrpcAdapter) newTicket() (string, chan *v1.TriggerResponse) {
+// for the requesting thread to wait on. Returns ErrDraining if the worker is leaving the pool.
+func (s *GrpcAdapter) newTicket() (string, chan *v1.TriggerResponse, error) {
 	s.responseQueueLock.Lock()
 	defer s.responseQueueLock.Unlock()
 
+	if s.draining {
+		return "", nil, ErrDraining
+	}
+
 	ID := uuid.New().String()
-	responseChan := make(chan *v1.TriggerResponse)
+	// Buffered so the stream is never blocked by a waiter that has given up
+	responseChan := make(chan *v1.TriggerResponse, 1)
 
 	s.responseQueue[ID] = responseChan
 
-	return ID, responseChan
+	return ID, responseChan, nil
 }
 
 // resolveTicket - Retrieves a response channel from the queue for
@@ -70,55 +157,402 @@ func (s *GrpcAdapter) resolveTicket(ID string) (chan *v1.TriggerResponse, error)
 	return s.responseQueue[ID], nil
 }
 
+// cancelTicket - Removes an unresolved ticket, so a late response for it will be discarded.
+// Returns false if the ticket has already been resolved.
+func (s *GrpcAdapter) cancelTicket(ID string) bool {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	if _, ok := s.responseQueue[ID]; !ok {
+		return false
+	}
+
+	delete(s.responseQueue, ID)
+
+	now := time.Now()
+	// Forget requests whose responses are no longer expected, so workers that never respond don't grow the map
+	for cancelledID, at := range s.cancelled {
+		if now.Sub(at) > cancelledTTL {
+			delete(s.cancelled, cancelledID)
+		}
+	}
+
+	s.cancelled[ID] = now
+
+	return true
+}
+
+// discardCancelled - Returns true if the ID belongs to a cancelled request, forgetting it
+func (s *GrpcAdapter) discardCancelled(ID string) bool {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	if _, ok := s.cancelled[ID]; !ok {
+		return false
+	}
+
+	delete(s.cancelled, ID)
+
+	return true
+}
+
+// waitForResponse - Waits for the response to the request with the given ID, or for the context to be done.
+// If the context is done first the ticket is cancelled and the worker is asked to abort the request.
+func (s *GrpcAdapter) waitForResponse(ctx context.Context, ID string, returnChan chan *v1.TriggerResponse) (*v1.TriggerResponse, error) {
+	select {
+	case response := <-returnChan:
+		return response, nil
+	case <-s.exited:
+		select {
+		case response := <-returnChan:
+			// The response arrived before the worker exited
+			return response, nil
+		default:
+			return nil, s.exitErr
+		}
+	case <-ctx.Done():
+		if !s.cancelTicket(ID) {
+			// The response arrived as the context finished
+			return <-returnChan, nil
+		}
+
+		s.sendCancel(ctx, ID, ctx.Err())
+
+		return nil, ctx.Err()
+	}
+}
+
+// sendCancel - asks the worker to abort processing the request with the given ID
+func (s *GrpcAdapter) sendCancel(ctx context.Context, ID string, reason error) {
+	err := s.send(&v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_CancelRequest{
+			CancelRequest: &v1.CancelRequest{
+				Reason: reason.Error(),
+			},
+		},
+	})
+	if err != nil {
+		s.requestLogger(ctx, ID).WithError(err).Error("could not cancel request")
+	}
+}
+
+// sendRequestBody - streams the request body to the worker in chunks, following the trigger request with the given ID
+func (s *GrpcAdapter) sendRequestBody(ctx context.Context, ID string, body io.Reader) error {
+	return readChunks(body, func(data []byte, end bool) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return s.send(&v1.ServerMessage{
+			Id: ID,
+			Content: &v1.ServerMessage_BodyChunk{
+				BodyChunk: &v1.BodyChunk{
+					Data: data,
+					End:  end,
+				},
+			},
+		})
+	})
+}
+
+// openResponseBody - registers a response body for the given ID, for the chunks that follow its response
+func (s *GrpcAdapter) openResponseBody(ID string) {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	body := newResponseBody()
+	s.responseBodies[ID] = body
+	s.responseReaders[ID] = body
+}
+
+// takeResponseBody - returns the response body for the given ID, nil if none was opened
+func (s *GrpcAdapter) takeResponseBody(ID string) *responseBody {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	reader := s.responseReaders[ID]
+	delete(s.responseReaders, ID)
+
+	return reader
+}
+
+// writeResponseBody - writes a chunk to the response body it belongs to, ending the body on its last chunk.
+// A body whose reader has fallen too far behind is aborted rather than blocking the worker's stream.
+func (s *GrpcAdapter) writeResponseBody(ID string, chunk *v1.BodyChunk) {
+	s.responseQueueLock.Lock()
+	body, ok := s.responseBodies[ID]
+	if ok && chunk.GetEnd() {
+		delete(s.responseBodies, ID)
+	}
+
+	_, cancelled := s.cancelled[ID]
+	if cancelled && chunk.GetEnd() {
+		delete(s.cancelled, ID)
+	}
+	s.responseQueueLock.Unlock()
+
+	if !ok {
+		if !cancelled {
+			s.logger().WithField(logger.RequestIDKey, ID).Warn("received body chunk for unknown response")
+		}
+		return
+	}
+
+	if !body.write(chunk.GetData()) {
+		s.abortResponseBody(ID, body, chunk.GetEnd())
+		return
+	}
+
+	if chunk.GetEnd() {
+		body.close(nil)
+	}
+}
+
+// abortResponseBody - ends a response body whose reader has fallen too far behind,
+// asking the worker to stop sending it and discarding the chunks already on their way
+func (s *GrpcAdapter) abortResponseBody(ID string, body *responseBody, end bool) {
+	body.close(errBodyBufferFull)
+
+	if end {
+		return
+	}
+
+	s.responseQueueLock.Lock()
+	delete(s.responseBodies, ID)
+	s.cancelled[ID] = time.Now()
+	s.responseQueueLock.Unlock()
+
+	s.logger().WithField(logger.RequestIDKey, ID).Warn("response body is not being read, aborting it")
+
+	// The cancel is sent from another goroutine, so a worker that isn't reading can't stall its stream
+	go s.sendCancel(context.Background(), ID, errBodyBufferFull)
+}
+
+// abortResponseBodies - ends all unfinished response bodies with the given error
+func (s *GrpcAdapter) abortResponseBodies(err error) {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	for ID, body := range s.responseBodies {
+		body.close(err)
+		delete(s.responseBodies, ID)
+	}
+}
+
+// Draining - returns true if the worker is leaving the pool
+func (s *GrpcAdapter) Draining() bool {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	return s.draining
+}
+
+// Drain - stops sending new triggers to the worker and asks it to shut down once its outstanding triggers are complete
+func (s *GrpcAdapter) Drain(reason string) error {
+	s.startDraining()
+
+	return s.send(&v1.ServerMessage{
+		Content: &v1.ServerMessage_DrainRequest{
+			DrainRequest: &v1.DrainRequest{
+				Reason: reason,
+			},
+		},
+	})
+}
+
+// startDraining - stops new tickets being issued
+func (s *GrpcAdapter) startDraining() {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	s.draining = true
+}
+
+// outstanding - the number of triggers awaiting a response or the rest of their response body from the worker
+func (s *GrpcAdapter) outstanding() int {
+	s.responseQueueLock.Lock()
+	defer s.responseQueueLock.Unlock()
+
+	return len(s.responseQueue) + len(s.responseBodies)
+}
+
+// shutdown - stops new triggers being sent to the worker and confirms its shutdown request once its outstanding triggers are complete,
+// unless the stream closes first
+func (s *GrpcAdapter) shutdown(closed chan struct{}) {
+	s.startDraining()
+
+	ticker := time.NewTicker(time.Duration(5) * time.Millisecond)
+	defer ticker.Stop()
+
+	for s.outstanding() > 0 {
+		select {
+		case <-closed:
+			return
+		case <-ticker.C:
+		}
+	}
+
+	s.logger().Info("worker drained")
+
+	err := s.send(&v1.ServerMessage{
+		Content: &v1.ServerMessage_ShutdownResponse{
+			ShutdownResponse: &v1.ShutdownResponse{},
+		},
+	})
+	if err != nil {
+		s.logger().WithError(err).Error("could not send shutdown response")
+	}
+}
+
 func (gwb *GrpcAdapter) send(msg *v1.ServerMessage) error {
+	gwb.sendLock.Lock()
+	defer gwb.sendLock.Unlock()
+
 	return gwb.stream.Send(msg)
 }
 
+// exit - fails the worker's outstanding triggers and reports its exit, only the first exit is reported
+func (gwb *GrpcAdapter) exit(errchan chan error, err error) {
+	gwb.exitOnce.Do(func() {
+		gwb.fail(err)
+		errchan <- err
+	})
+}
+
+// fail - stops new triggers being sent to the worker, and fails those awaiting a response or the rest of their response body
+func (gwb *GrpcAdapter) fail(err error) {
+	gwb.responseQueueLock.Lock()
+	gwb.draining = true
+	gwb.exitErr = fmt.Errorf("worker exited before responding: %w", err)
+
+	for ID := range gwb.responseQueue {
+		delete(gwb.responseQueue, ID)
+	}
+
+	for ID := range gwb.cancelled {
+		delete(gwb.cancelled, ID)
+	}
+
+	if gwb.exited != nil {
+		close(gwb.exited)
+	}
+	gwb.responseQueueLock.Unlock()
+
+	gwb.abortResponseBodies(err)
+}
+
 func (gwb *GrpcAdapter) Start(errchan chan error) {
+	closed := make(chan struct{})
+	defer close(closed)
+
+	if gwb.heartbeatInterval > 0 {
+		// The heartbeat timeout runs from the start of the stream
+		gwb.seen()
+		go gwb.heartbeat(errchan, closed)
+	}
+
 	for {
 		msg, err := gwb.stream.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				// return will close stream from server side
-				log.Println("exit")
+				gwb.logger().Info("worker stream closed")
+			} else {
+				gwb.logger().WithError(err).Error("worker stream failed")
 			}
-			log.Printf("received error %v", err)
 
-			errchan <- err
+			gwb.exit(errchan, err)
 			return
 		}
 
+		gwb.seen()
+
+		if msg.GetPong() != nil {
+			continue
+		}
+
 		if msg.GetInitRequest() != nil {
-			log.Default().Println("Received init request from worker")
-			err = gwb.stream.Send(&v1.ServerMessage{
+			gwb.logger().Info("received init request from worker")
+			err = gwb.send(&v1.ServerMessage{
 				Content: &v1.ServerMessage_InitResponse{
 					InitResponse: &v1.InitResponse{},
 				},
 			})
 			if err != nil {
-				log.Default().Printf("send error %v", err)
+				gwb.logger().WithError(err).Error("could not send init response")
 			}
 			continue
 		}
 
+		if msg.GetShutdownRequest() != nil {
+			gwb.logger().WithField("outstanding", gwb.outstanding()).Info("received shutdown request from worker, draining")
+			go gwb.shutdown(closed)
+			continue
+		}
+
+		if chunk := msg.GetBodyChunk(); chunk != nil {
+			gwb.writeResponseBody(msg.GetId(), chunk)
+			continue
+		}
+
 		// Load the response channel and delete its map key reference
 		val, err := gwb.resolveTicket(msg.GetId())
-		if err != nil {
+		if err != nil && gwb.discardCancelled(msg.GetId()) {
+			// The request timed out or was cancelled, no one is waiting on this response
+			continue
+		} else if err != nil {
 			err = errors.WithMessage(err, "Fatal: FaaS Worker in bad state closing stream: "+msg.GetId())
-			log.Default().Println(err.Error())
-			errchan <- err
+			gwb.logger().WithError(err).Error("worker in bad state")
+			gwb.exit(errchan, err)
 			return
 		}
 		// For now assume this is a trigger response...
 		response := msg.GetTriggerResponse()
+		// Register the body before handing over the response, as its chunks follow immediately
+		if response.GetHttp().GetStreamedBody() {
+			gwb.openResponseBody(msg.GetId())
+		}
 		// Write the response the the waiting recipient
 		val <- response
 	}
 }
 
+// gatewayTimeout - the response to a http request whose deadline passed before the worker responded
+func gatewayTimeout() *triggers.HttpResponse {
+	return &triggers.HttpResponse{
+		StatusCode: http.StatusGatewayTimeout,
+		Body:       []byte("Gateway Timeout"),
+	}
+}
+
 func (s *GrpcAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	atomic.AddInt64(&s.inFlight, 1)
+	defer atomic.AddInt64(&s.inFlight, -1)
+
+	if err := s.limit.acquire(ctx); errors.Is(err, context.DeadlineExceeded) {
+		return gatewayTimeout(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer s.limit.release()
+
+	// Buffer the body for workers that don't support streaming
+	if trigger.BodyStream != nil && !s.bodyStreaming {
+		body, err := io.ReadAll(trigger.BodyStream)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error reading request body")
+		}
+
+		trigger.Body = body
+		trigger.BodyStream = nil
+	}
+
 	// Generate an ID here
-	ID, returnChan := s.newTicket()
+	ID, returnChan, err := s.newTicket()
+	if err != nil {
+		return nil, err
+	}
 
 	var mimeType string = ""
 	if trigger.Header != nil && len(trigger.Header["Content-Type"]) > 0 {
@@ -155,6 +589,27 @@ func (s *GrpcAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.H
 		}
 	}
 
+	var claims *structpb.Struct
+	if trigger.Claims != nil {
+		var err error
+		claims, err = structpb.NewStruct(trigger.Claims)
+		if err != nil {
+			return nil, errors.WithMessage(err, "unable to convert token claims")
+		}
+	}
+
+	var options *v1.ApiWorkerOptions
+	if trigger.Api != "" {
+		options = &v1.ApiWorkerOptions{
+			Security:         make(map[string]*v1.ApiWorkerScopes),
+			SecurityDisabled: trigger.SecurityDisabled,
+		}
+
+		for name, scopes := range trigger.Security {
+			options.Security[name] = &v1.ApiWorkerScopes{Scopes: scopes}
+		}
+	}
+
 	triggerRequest := &v1.TriggerRequest{
 		Data:         trigger.Body,
 		MimeType:     mimeType,
@@ -168,6 +623,10 @@ func (s *GrpcAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.H
 				Headers:        headers,
 				HeadersOld:     headersOld,
 				PathParams:     trigger.Params,
+				Claims:         claims,
+				Api:            trigger.Api,
+				Options:        options,
+				StreamedBody:   trigger.BodyStream != nil,
 			},
 		},
 	}
@@ -181,14 +640,30 @@ func (s *GrpcAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.H
 	}
 
 	// send the message
-	err := s.send(message)
+	err = s.send(message)
 	if err != nil {
-		// There was an error enqueuing the message
+		// There was an error enqueuing the message, no response will arrive for the ticket
+		_, _ = s.resolveTicket(ID)
 		return nil, err
 	}
 
+	if trigger.BodyStream != nil {
+		if err := s.sendRequestBody(ctx, ID, trigger.BodyStream); err != nil {
+			if s.cancelTicket(ID) {
+				s.sendCancel(ctx, ID, err)
+			}
+
+			return nil, errors.WithMessage(err, "error streaming request body")
+		}
+	}
+
 	// wait for the response
-	triggerResponse := <-returnChan
+	triggerResponse, err := s.waitForResponse(ctx, ID, returnChan)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return gatewayTimeout(), nil
+	} else if err != nil {
+		return nil, err
+	}
 
 	httpResponse := triggerResponse.GetHttp()
 
@@ -217,21 +692,48 @@ func (s *GrpcAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.H
 		Header:     fasthttpHeader,
 	}
 
+	if httpResponse.GetStreamedBody() {
+		response.Body = nil
+		// Closing the stream, e.g. when the client disconnects, drops the chunks that follow
+		response.BodyStream = s.takeResponseBody(ID).readCloser(triggerResponse.Data)
+	}
+
 	return response, nil
 }
 
 func (s *GrpcAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	atomic.AddInt64(&s.inFlight, 1)
+	defer atomic.AddInt64(&s.inFlight, -1)
+
+	if err := s.limit.acquire(ctx); err != nil {
+		return err
+	}
+	defer s.limit.release()
+
 	// Generate an ID here
-	ID, returnChan := s.newTicket()
+	ID, returnChan, err := s.newTicket()
+	if err != nil {
+		return err
+	}
+
+	topicContext := &v1.TopicTriggerContext{
+		Topic:           trigger.Topic,
+		Id:              trigger.ID,
+		PayloadType:     trigger.PayloadType,
+		Attributes:      trigger.Attributes,
+		DeliveryAttempt: uint32(trigger.DeliveryAttempt),
+	}
+
+	if !trigger.PublishTime.IsZero() {
+		topicContext.PublishTime = timestamppb.New(trigger.PublishTime)
+	}
+
 	triggerRequest := &v1.TriggerRequest{
 		Data:         trigger.Payload,
 		MimeType:     http.DetectContentType(trigger.Payload),
 		TraceContext: span.ToTraceContext(ctx),
 		Context: &v1.TriggerRequest_Topic{
-			Topic: &v1.TopicTriggerContext{
-				Topic: trigger.Topic,
-				// FIXME: Add missing fields here...
-			},
+			Topic: topicContext,
 		},
 	}
 
@@ -244,15 +746,18 @@ func (s *GrpcAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event)
 	}
 
 	// send the message
-	err := s.send(message)
+	err = s.send(message)
 	if err != nil {
-		// There was an error enqueuing the message
+		// There was an error enqueuing the message, no response will arrive for the ticket
+		_, _ = s.resolveTicket(ID)
 		return err
 	}
 
 	// wait for the response
-	// FIXME: Need to handle timeouts here...
-	response := <-returnChan
+	response, err := s.waitForResponse(ctx, ID, returnChan)
+	if err != nil {
+		return errors.WithMessage(err, "error waiting for event response")
+	}
 
 	topic := response.GetTopic()
 
@@ -269,10 +774,185 @@ func (s *GrpcAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event)
 	return fmt.Errorf("Error occurred handling the event")
 }
 
-func NewGrpcAdapter(stream v1.FaasService_TriggerStreamServer) *GrpcAdapter {
+func (s *GrpcAdapter) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	atomic.AddInt64(&s.inFlight, 1)
+	defer atomic.AddInt64(&s.inFlight, -1)
+
+	if err := s.limit.acquire(ctx); err != nil {
+		return err
+	}
+	defer s.limit.release()
+
+	query := make(map[string]*v1.QueryValue)
+	for k, v := range trigger.Query {
+		if v != nil {
+			query[k] = &v1.QueryValue{
+				Value: v,
+			}
+		}
+	}
+
+	ID, returnChan, err := s.newTicket()
+	if err != nil {
+		return err
+	}
+
+	triggerRequest := &v1.TriggerRequest{
+		Data:         trigger.Body,
+		MimeType:     http.DetectContentType(trigger.Body),
+		TraceContext: span.ToTraceContext(ctx),
+		Context: &v1.TriggerRequest_Websocket{
+			Websocket: &v1.WebsocketTriggerContext{
+				Socket:       trigger.Socket,
+				Event:        v1.WebsocketEvent(trigger.Event),
+				ConnectionId: trigger.ConnectionID,
+				QueryParams:  query,
+			},
+		},
+	}
+
+	message := &v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_TriggerRequest{
+			TriggerRequest: triggerRequest,
+		},
+	}
+
+	err = s.send(message)
+	if err != nil {
+		// There was an error enqueuing the message, no response will arrive for the ticket
+		_, _ = s.resolveTicket(ID)
+		return err
+	}
+
+	response, err := s.waitForResponse(ctx, ID, returnChan)
+	if err != nil {
+		return errors.WithMessage(err, "error waiting for websocket response")
+	}
+
+	websocket := response.GetWebsocket()
+
+	if websocket == nil {
+		// We don't have the correct response type for this handler
+		return fmt.Errorf("Fatal: Error handling websocket message, incorrect response received from function")
+	}
+
+	if websocket.GetSuccess() {
+		return nil
+	}
+
+	return fmt.Errorf("Error occurred handling the websocket %s event", trigger.Event)
+}
+
+func (s *GrpcAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	atomic.AddInt64(&s.inFlight, 1)
+	defer atomic.AddInt64(&s.inFlight, -1)
+
+	if err := s.limit.acquire(ctx); err != nil {
+		return err
+	}
+	defer s.limit.release()
+
+	ID, returnChan, err := s.newTicket()
+	if err != nil {
+		return err
+	}
+
+	triggerRequest := &v1.TriggerRequest{
+		TraceContext: span.ToTraceContext(ctx),
+		Context: &v1.TriggerRequest_Schedule{
+			Schedule: &v1.ScheduleTriggerContext{
+				Key:           trigger.Key,
+				ScheduledTime: timestamppb.New(trigger.ScheduledTime),
+				FireTime:      timestamppb.New(trigger.FireTime),
+				Cadence:       trigger.Cadence,
+			},
+		},
+	}
+
+	message := &v1.ServerMessage{
+		Id: ID,
+		Content: &v1.ServerMessage_TriggerRequest{
+			TriggerRequest: triggerRequest,
+		},
+	}
+
+	err = s.send(message)
+	if err != nil {
+		// There was an error enqueuing the message, no response will arrive for the ticket
+		_, _ = s.resolveTicket(ID)
+		return err
+	}
+
+	response, err := s.waitForResponse(ctx, ID, returnChan)
+	if err != nil {
+		return errors.WithMessage(err, "error waiting for schedule response")
+	}
+
+	var success bool
+	if schedule := response.GetSchedule(); schedule != nil {
+		success = schedule.GetSuccess()
+	} else if topic := response.GetTopic(); topic != nil {
+		// Schedules were previously delivered as topic events, which workers may still respond to in kind
+		success = topic.GetSuccess()
+	} else {
+		// We don't have the correct response type for this handler
+		return fmt.Errorf("Fatal: Error handling schedule, incorrect response received from function")
+	}
+
+	if success {
+		return nil
+	}
+
+	return fmt.Errorf("Error occurred handling the schedule %s", trigger.Key)
+}
+
+type GrpcAdapterOptions struct {
+	// The worker supports streamed http bodies
+	BodyStreaming bool
+	// The worker handles schedule triggers, otherwise schedules are sent to it as events
+	ScheduleTriggers bool
+	// The interval to ping the worker at, heartbeats are disabled if 0
+	HeartbeatInterval time.Duration
+	// The time without messages from the worker after which it is unresponsive, defaults to 3 heartbeat intervals
+	HeartbeatTimeout time.Duration
+	// The maximum number of triggers sent to the worker concurrently, unlimited if less than 1
+	MaxConcurrency int
+	// The time a trigger waits for the worker to finish another before ErrOverloaded is returned
+	ConcurrencyWait time.Duration
+	// Defaults to the default logger
+	Logger *logrus.Entry
+}
+
+func NewGrpcAdapter(stream v1.FaasService_TriggerStreamServer, opts *GrpcAdapterOptions) *GrpcAdapter {
+	if opts == nil {
+		opts = &GrpcAdapterOptions{}
+	}
+
+	log := opts.Logger
+	if log == nil {
+		log = logger.Default()
+	}
+
+	heartbeatTimeout := opts.HeartbeatTimeout
+	if heartbeatTimeout <= 0 {
+		heartbeatTimeout = 3 * opts.HeartbeatInterval
+	}
+
 	return &GrpcAdapter{
 		stream:            stream,
+		heartbeatInterval: opts.HeartbeatInterval,
+		heartbeatTimeout:  heartbeatTimeout,
+		lastSeen:          time.Now().UnixNano(),
+		bodyStreaming:     opts.BodyStreaming,
+		scheduleTriggers:  opts.ScheduleTriggers,
+		responseBodies:    make(map[string]*responseBody),
+		responseReaders:   make(map[string]*responseBody),
 		responseQueueLock: &sync.Mutex{},
 		responseQueue:     make(map[string]chan *v1.TriggerResponse),
+		cancelled:         make(map[string]time.Time),
+		exited:            make(chan struct{}),
+		limit:             newConcurrencyLimit(opts.MaxConcurrency, opts.ConcurrencyWait),
+		log:               log,
 	}
 }
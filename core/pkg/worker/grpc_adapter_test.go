@@ -16,9 +16,12 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -49,8 +52,9 @@ var _ = Describe("grpcAdapter", func() {
 				lck.EXPECT().Unlock().Times(1)
 
 				By("returning a new response UUID")
-				id, ch := wkr.newTicket()
-				_, err := uuid.Parse(id)
+				id, ch, err := wkr.newTicket()
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = uuid.Parse(id)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				By("returning a new trigger response channel")
@@ -174,6 +178,48 @@ var _ = Describe("grpcAdapter", func() {
 			})
 		})
 
+		When("receiving a late response for a cancelled request", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			errChan := make(chan error)
+			wkr := NewGrpcAdapter(stream, nil)
+			wkr.cancelled["late"] = time.Now()
+
+			It("should discard the response and keep listening", func() {
+				gomock.InOrder(
+					stream.EXPECT().Recv().Return(&v1.ClientMessage{
+						Id: "late",
+						Content: &v1.ClientMessage_TriggerResponse{
+							TriggerResponse: &v1.TriggerResponse{},
+						},
+					}, nil),
+					stream.EXPECT().Recv().Return(nil, io.EOF),
+				)
+
+				go wkr.Start(errChan)
+
+				Expect(<-errChan).To(Equal(io.EOF))
+				Expect(wkr.cancelled).To(BeEmpty())
+			})
+		})
+
+		When("the worker exits with cancelled requests", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			errChan := make(chan error)
+			wkr := NewGrpcAdapter(stream, nil)
+			wkr.cancelled["unanswered"] = time.Now()
+
+			It("should forget them", func() {
+				stream.EXPECT().Recv().Return(nil, io.EOF)
+
+				go wkr.Start(errChan)
+
+				Expect(<-errChan).To(Equal(io.EOF))
+				Expect(wkr.cancelled).To(BeEmpty())
+			})
+		})
+
 		When("receiving invalid trigger responses", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
@@ -292,12 +338,249 @@ var _ = Describe("grpcAdapter", func() {
 			})
 		})
 
+		When("the request deadline is exceeded", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should respond with a gateway timeout and cancel the request", func() {
+				var requestId string
+
+				By("sending the trigger request then a cancel request")
+				gomock.InOrder(
+					stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+						requestId = msg.GetId()
+						return nil
+					}),
+					stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+						Expect(msg.GetId()).To(Equal(requestId))
+						Expect(msg.GetCancelRequest()).ToNot(BeNil())
+						return nil
+					}),
+				)
+
+				ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+				defer cancel()
+
+				resp, err := wkr.HandleHttpRequest(ctx, &triggers.HttpRequest{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(504))
+
+				By("removing the ticket")
+				Expect(wkr.responseQueue).To(BeEmpty())
+				Expect(wkr.cancelled).To(HaveKey(requestId))
+			})
+		})
+
+		When("cancelling a request after others have expired", func() {
+			wkr := NewGrpcAdapter(nil, nil)
+			wkr.cancelled["expired"] = time.Now().Add(-2 * cancelledTTL)
+			wkr.cancelled["recent"] = time.Now()
+
+			It("should forget the expired requests", func() {
+				wkr.responseQueue["current"] = make(chan *v1.TriggerResponse, 1)
+
+				Expect(wkr.cancelTicket("current")).To(BeTrue())
+				Expect(wkr.cancelled).To(HaveKey("current"))
+				Expect(wkr.cancelled).To(HaveKey("recent"))
+				Expect(wkr.cancelled).ToNot(HaveKey("expired"))
+			})
+		})
+
+		When("a streamed response body is not being read", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{BodyStreaming: true})
+
+			sent := make(chan *v1.ServerMessage, 10)
+			received := make(chan *v1.ClientMessage, bodyBufferChunks+10)
+
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+				sent <- msg
+				return nil
+			}).AnyTimes()
+
+			stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+				msg, ok := <-received
+				if !ok {
+					return nil, io.EOF
+				}
+				return msg, nil
+			}).AnyTimes()
+
+			It("should abort the body without blocking the worker's other triggers", func() {
+				errChan := make(chan error, 1)
+				go wkr.Start(errChan)
+				defer close(received)
+
+				wkr.openResponseBody("slow")
+				reader := wkr.takeResponseBody("slow").readCloser(nil)
+
+				otherID, otherChan, err := wkr.newTicket()
+				Expect(err).ShouldNot(HaveOccurred())
+
+				for i := 0; i < bodyBufferChunks+2; i++ {
+					received <- &v1.ClientMessage{
+						Id: "slow",
+						Content: &v1.ClientMessage_BodyChunk{
+							BodyChunk: &v1.BodyChunk{Data: []byte("chunk")},
+						},
+					}
+				}
+				received <- &v1.ClientMessage{
+					Id: otherID,
+					Content: &v1.ClientMessage_TriggerResponse{
+						TriggerResponse: &v1.TriggerResponse{
+							Context: &v1.TriggerResponse_Topic{
+								Topic: &v1.TopicResponseContext{Success: true},
+							},
+						},
+					},
+				}
+
+				By("delivering the responses that follow")
+				Eventually(otherChan).Should(Receive())
+
+				By("asking the worker to stop sending the body")
+				var cancel *v1.ServerMessage
+				Eventually(sent).Should(Receive(&cancel))
+				Expect(cancel.GetId()).To(Equal("slow"))
+				Expect(cancel.GetCancelRequest()).ToNot(BeNil())
+
+				By("ending the body with an error")
+				_, err = io.ReadAll(reader)
+				Expect(err).To(MatchError(errBodyBufferFull))
+			})
+		})
+
+		When("the worker supports streamed bodies", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{BodyStreaming: true})
+
+			sent := make(chan *v1.ServerMessage, 10)
+			received := make(chan *v1.ClientMessage, 10)
+
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+				sent <- msg
+				return nil
+			}).AnyTimes()
+
+			stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+				msg, ok := <-received
+				if !ok {
+					return nil, io.EOF
+				}
+				return msg, nil
+			}).AnyTimes()
+
+			It("should stream the request and response bodies in chunks", func() {
+				errChan := make(chan error, 1)
+				go wkr.Start(errChan)
+				defer close(received)
+
+				requestBody := strings.Repeat("a", bodyChunkSize+10)
+
+				type result struct {
+					resp *triggers.HttpResponse
+					err  error
+				}
+				done := make(chan result)
+
+				go func() {
+					resp, err := wkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{
+						BodyStream: strings.NewReader(requestBody),
+					})
+					done <- result{resp, err}
+				}()
+
+				By("sending the trigger request with a streamed body")
+				req := <-sent
+				Expect(req.GetTriggerRequest().GetHttp().GetStreamedBody()).To(BeTrue())
+				Expect(req.GetTriggerRequest().GetData()).To(BeEmpty())
+
+				By("sending the body in chunks")
+				body := ""
+				for {
+					chunk := <-sent
+					Expect(chunk.GetId()).To(Equal(req.GetId()))
+					body += string(chunk.GetBodyChunk().GetData())
+					if chunk.GetBodyChunk().GetEnd() {
+						break
+					}
+				}
+				Expect(body).To(Equal(requestBody))
+
+				By("streaming the response body that follows the response")
+				received <- &v1.ClientMessage{
+					Id: req.GetId(),
+					Content: &v1.ClientMessage_TriggerResponse{
+						TriggerResponse: &v1.TriggerResponse{
+							Data: []byte("hello "),
+							Context: &v1.TriggerResponse_Http{
+								Http: &v1.HttpResponseContext{Status: 200, StreamedBody: true},
+							},
+						},
+					},
+				}
+				received <- &v1.ClientMessage{
+					Id: req.GetId(),
+					Content: &v1.ClientMessage_BodyChunk{
+						BodyChunk: &v1.BodyChunk{Data: []byte("world"), End: true},
+					},
+				}
+
+				r := <-done
+				Expect(r.err).ShouldNot(HaveOccurred())
+				Expect(r.resp.StatusCode).To(Equal(200))
+
+				responseBody, err := io.ReadAll(r.resp.BodyStream)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(string(responseBody)).To(Equal("hello world"))
+			})
+		})
+
+		When("the worker does not support streamed bodies", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should send the full body with the trigger request", func() {
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					Expect(msg.GetTriggerRequest().GetHttp().GetStreamedBody()).To(BeFalse())
+					Expect(string(msg.GetTriggerRequest().GetData())).To(Equal("body"))
+					return fmt.Errorf("stop here")
+				})
+
+				_, err := wkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{
+					BodyStream: strings.NewReader("body"),
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
 		PWhen("the worker successfully responds", func() {
 			// TODO
 		})
 	})
 
 	Context("HandleEvent", func() {
+		When("the request is cancelled", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should return the cancellation error", func() {
+				stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+
+				ctx, cancel := context.WithCancel(context.TODO())
+				cancel()
+
+				err := wkr.HandleEvent(ctx, &triggers.Event{})
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+			})
+		})
+
 		When("the worker connection responds with an error", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
@@ -318,8 +601,108 @@ var _ = Describe("grpcAdapter", func() {
 			})
 		})
 
+		When("the event has metadata from its source", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+			publishTime := time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC)
+
+			It("should send the metadata in the topic context", func() {
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					topic := msg.GetTriggerRequest().GetTopic()
+					Expect(topic.GetTopic()).To(Equal("orders"))
+					Expect(topic.GetId()).To(Equal("evt-1"))
+					Expect(topic.GetPayloadType()).To(Equal("order.created"))
+					Expect(topic.GetAttributes()).To(HaveKeyWithValue("region", "eu"))
+					Expect(topic.GetPublishTime().AsTime()).To(Equal(publishTime))
+					Expect(topic.GetDeliveryAttempt()).To(BeEquivalentTo(2))
+					return fmt.Errorf("stop here")
+				})
+
+				err := wkr.HandleEvent(context.TODO(), &triggers.Event{
+					ID:              "evt-1",
+					Topic:           "orders",
+					PayloadType:     "order.created",
+					Attributes:      map[string]string{"region": "eu"},
+					PublishTime:     publishTime,
+					DeliveryAttempt: 2,
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
 		PWhen("the worker successfully responds", func() {
 			// TODO
 		})
 	})
+
+	Context("HandleWebsocketMessage", func() {
+		respondWith := func(wkr *GrpcAdapter, ctx *v1.WebsocketResponseContext) func(*v1.ServerMessage) error {
+			return func(msg *v1.ServerMessage) error {
+				go func() {
+					ch, err := wkr.resolveTicket(msg.GetId())
+					if err == nil {
+						ch <- &v1.TriggerResponse{
+							Context: &v1.TriggerResponse_Websocket{
+								Websocket: ctx,
+							},
+						}
+					}
+				}()
+
+				return nil
+			}
+		}
+
+		When("the worker accepts the connection", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should send the websocket context and return no error", func() {
+				var sent *v1.ServerMessage
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+					sent = msg
+					return respondWith(wkr, &v1.WebsocketResponseContext{Success: true})(msg)
+				})
+
+				err := wkr.HandleWebsocketMessage(context.TODO(), &triggers.WebsocketMessage{
+					Socket:       "chat",
+					Event:        triggers.WebsocketEvent_Connect,
+					ConnectionID: "conn-1",
+					Query: map[string][]string{
+						"room": {"general"},
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("sending the websocket trigger context")
+				ws := sent.GetTriggerRequest().GetWebsocket()
+				Expect(ws.GetSocket()).To(Equal("chat"))
+				Expect(ws.GetEvent()).To(Equal(v1.WebsocketEvent_Connect))
+				Expect(ws.GetConnectionId()).To(Equal("conn-1"))
+				Expect(ws.GetQueryParams()["room"].GetValue()).To(Equal([]string{"general"}))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("the worker rejects the connection", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+			wkr := NewGrpcAdapter(stream, nil)
+
+			It("should return an error", func() {
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(respondWith(wkr, &v1.WebsocketResponseContext{Success: false}))
+
+				err := wkr.HandleWebsocketMessage(context.TODO(), &triggers.WebsocketMessage{
+					Socket: "chat",
+					Event:  triggers.WebsocketEvent_Connect,
+				})
+				Expect(err).Should(HaveOccurred())
+
+				ctrl.Finish()
+			})
+		})
+	})
 })
@@ -0,0 +1,81 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+)
+
+// ErrUnresponsive - the exit reason of a worker that stopped responding to heartbeats
+var ErrUnresponsive = errors.New("worker is unresponsive")
+
+// LastSeen - returns when a message was last received from the worker
+func (s *GrpcAdapter) LastSeen() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&s.lastSeen))
+}
+
+// seen - records that a message was received from the worker
+func (s *GrpcAdapter) seen() {
+	atomic.StoreInt64(&s.lastSeen, time.Now().UnixNano())
+}
+
+// heartbeat - pings the worker at the heartbeat interval until the stream closes,
+// reporting the worker's exit with ErrUnresponsive if nothing is received from it within the heartbeat timeout
+func (s *GrpcAdapter) heartbeat(errchan chan error, closed chan struct{}) {
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-ticker.C:
+		}
+
+		if silence := time.Since(s.LastSeen()); silence > s.heartbeatTimeout {
+			s.logger().WithField("last_seen", s.LastSeen().Format(time.RFC3339Nano)).Error("worker is unresponsive, removing it")
+			s.exit(errchan, fmt.Errorf("%w, nothing received for %s", ErrUnresponsive, silence.Round(time.Millisecond)))
+
+			return
+		}
+
+		// The send blocks if a wedged worker has stopped reading the stream, which must not delay detecting it
+		if atomic.CompareAndSwapInt32(&s.pinging, 0, 1) {
+			go s.ping()
+		}
+	}
+}
+
+// ping - sends a ping to the worker
+func (s *GrpcAdapter) ping() {
+	defer atomic.StoreInt32(&s.pinging, 0)
+
+	err := s.send(&v1.ServerMessage{
+		Id: uuid.New().String(),
+		Content: &v1.ServerMessage_Ping{
+			Ping: &v1.Ping{},
+		},
+	})
+	if err != nil {
+		s.logger().WithError(err).Warn("could not ping worker")
+	}
+}
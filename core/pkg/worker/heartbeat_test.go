@@ -0,0 +1,167 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_nitric "github.com/nitrictech/nitric/core/mocks/nitric"
+	v1 "github.com/nitrictech/nitric/core/pkg/api/nitric/v1"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+var _ = Describe("Heartbeat", func() {
+	When("the worker responds to pings", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+		errChan := make(chan error, 1)
+		wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{
+			HeartbeatInterval: 10 * time.Millisecond,
+			HeartbeatTimeout:  50 * time.Millisecond,
+		})
+
+		It("should keep the worker", func() {
+			pings := make(chan string, 10)
+			closeStream := make(chan struct{})
+
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *v1.ServerMessage) error {
+				if msg.GetPing() != nil {
+					pings <- msg.GetId()
+				}
+				return nil
+			}).AnyTimes()
+
+			stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+				select {
+				case ID := <-pings:
+					return &v1.ClientMessage{
+						Id: ID,
+						Content: &v1.ClientMessage_Pong{
+							Pong: &v1.Pong{},
+						},
+					}, nil
+				case <-closeStream:
+					return nil, io.EOF
+				}
+			}).AnyTimes()
+
+			before := wkr.LastSeen()
+			go wkr.Start(errChan)
+
+			By("updating the time the worker was last seen")
+			Eventually(wkr.LastSeen).Should(BeTemporally(">", before))
+
+			Consistently(errChan, 200*time.Millisecond).ShouldNot(Receive())
+
+			close(closeStream)
+			Eventually(errChan).Should(Receive(Equal(io.EOF)))
+		})
+	})
+
+	When("the worker stops responding", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+		errChan := make(chan error, 1)
+		wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{
+			HeartbeatInterval: 10 * time.Millisecond,
+			HeartbeatTimeout:  50 * time.Millisecond,
+		})
+
+		It("should exit with ErrUnresponsive", func() {
+			closeStream := make(chan struct{})
+
+			stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+			stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+				<-closeStream
+				return nil, io.EOF
+			})
+
+			go wkr.Start(errChan)
+
+			handled := make(chan error, 1)
+			go func() {
+				handled <- wkr.HandleEvent(context.Background(), &triggers.Event{Topic: "orders"})
+			}()
+
+			var err error
+			Eventually(errChan).Should(Receive(&err))
+			Expect(err).To(MatchError(ErrUnresponsive))
+
+			By("failing the triggers waiting on the worker")
+			var handleErr error
+			Eventually(handled).Should(Receive(&handleErr))
+			Expect(handleErr).To(MatchError(ErrUnresponsive))
+
+			By("reporting only the first exit")
+			close(closeStream)
+			Consistently(errChan, 20*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	When("the worker is evicted while a response body is being written", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		stream := mock_nitric.NewMockFaasService_TriggerStreamServer(ctrl)
+		errChan := make(chan error, 1)
+		wkr := NewGrpcAdapter(stream, &GrpcAdapterOptions{
+			BodyStreaming:     true,
+			HeartbeatInterval: 10 * time.Millisecond,
+			HeartbeatTimeout:  50 * time.Millisecond,
+		})
+
+		It("should abort the body without failing the write", func() {
+			wkr.openResponseBody("streaming")
+			reader := wkr.takeResponseBody("streaming").readCloser(nil)
+
+			stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+			stream.EXPECT().Recv().DoAndReturn(func() (*v1.ClientMessage, error) {
+				<-wkr.exited
+				return nil, io.EOF
+			}).AnyTimes()
+
+			read := make(chan error, 1)
+			go func() {
+				_, err := io.ReadAll(reader)
+				read <- err
+			}()
+
+			go wkr.Start(errChan)
+
+			// Chunks are written until the worker is evicted, racing the eviction's abort of the body
+			go func() {
+				for {
+					select {
+					case <-wkr.exited:
+						return
+					case <-time.After(time.Millisecond):
+						wkr.writeResponseBody("streaming", &v1.BodyChunk{Data: []byte("chunk")})
+					}
+				}
+			}()
+
+			var err error
+			Eventually(errChan).Should(Receive(&err))
+			Expect(err).To(MatchError(ErrUnresponsive))
+
+			By("ending the body with the exit reason")
+			Eventually(read).Should(Receive(MatchError(ErrUnresponsive)))
+		})
+	})
+})
@@ -24,11 +24,14 @@ import (
 	"github.com/valyala/fasthttp"
 
 	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/utils"
 )
 
 // A Nitric HTTP worker
 type HttpWorker struct {
+	// The host requests are addressed to, for unix sockets this is a placeholder as the client dials the socket directly
 	address string
+	client  *fasthttp.Client
 }
 
 func (s *HttpWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
@@ -55,7 +58,7 @@ func (h *HttpWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) e
 	httpRequest.Header.SetContentLength(len(trigger.Payload))
 
 	// TODO: Handle response or error and respond appropriately
-	err := fasthttp.Do(httpRequest, &resp)
+	err := h.client.Do(httpRequest, &resp)
 	if err == nil && resp.StatusCode() >= 200 && resp.StatusCode() <= 299 {
 		return nil
 	}
@@ -85,11 +88,16 @@ func (h *HttpWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.Ht
 	}
 
 	httpRequest.Header.Del("Content-Length")
-	httpRequest.SetBody(trigger.Body)
-	httpRequest.Header.SetContentLength(len(trigger.Body))
+	if trigger.BodyStream != nil {
+		// Stream the body through with chunked encoding
+		httpRequest.SetBodyStream(trigger.BodyStream, -1)
+	} else {
+		httpRequest.SetBody(trigger.Body)
+		httpRequest.Header.SetContentLength(len(trigger.Body))
+	}
 
 	var resp fasthttp.Response
-	err := fasthttp.Do(httpRequest, &resp)
+	err := h.client.Do(httpRequest, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -97,10 +105,12 @@ func (h *HttpWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.Ht
 	return triggers.FromHttpResponse(&resp), nil
 }
 
-// Creates a new HttpWorker
+// Creates a new HttpWorker for a host:port or unix:///path/to/socket address
 // Will wait to ensure that the provided address is dialable
 // before proceeding
 func NewHttpWorker(address string) (*HttpWorker, error) {
+	network, dialAddress := utils.SplitAddress(address)
+
 	// Dial the child port to see if it's open and ready...
 	maxWaitTime := time.Duration(5) * time.Second
 	// Longer poll times, e.g. 200 milliseconds results in slow lambda cold starts (15s+)
@@ -108,7 +118,7 @@ func NewHttpWorker(address string) (*HttpWorker, error) {
 
 	waitedTime := time.Duration(0)
 	for {
-		conn, _ := net.Dial("tcp", address)
+		conn, _ := net.Dial(network, dialAddress)
 		if conn != nil {
 			conn.Close()
 			break
@@ -122,8 +132,20 @@ func NewHttpWorker(address string) (*HttpWorker, error) {
 		}
 	}
 
+	if network == "unix" {
+		return &HttpWorker{
+			address: "localhost",
+			client: &fasthttp.Client{
+				Dial: func(string) (net.Conn, error) {
+					return net.Dial(network, dialAddress)
+				},
+			},
+		}, nil
+	}
+
 	// Dial the provided address to ensure its availability
 	return &HttpWorker{
 		address: address,
+		client:  &fasthttp.Client{},
 	}, nil
 }
@@ -0,0 +1,73 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+var _ = Describe("HttpWorker", func() {
+	When("the child listens on a unix socket", func() {
+		var dir string
+		var srv *http.Server
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "nitric-http-worker")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			lis, err := net.Listen("unix", filepath.Join(dir, "child.sock"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			srv = &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusAccepted)
+					_, _ = w.Write([]byte(r.URL.Path))
+				}),
+			}
+
+			go func() {
+				_ = srv.Serve(lis)
+			}()
+		})
+
+		AfterEach(func() {
+			_ = srv.Close()
+			_ = os.RemoveAll(dir)
+		})
+
+		It("should proxy http requests over the socket", func() {
+			wrkr, err := NewHttpWorker("unix://" + filepath.Join(dir, "child.sock"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			resp, err := wrkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{
+				Method: "GET",
+				Path:   "/test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+			Expect(string(resp.Body)).To(Equal("/test"))
+		})
+	})
+})
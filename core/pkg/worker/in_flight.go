@@ -0,0 +1,117 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// InFlightTracker - counts the triggers being handled by workers, so they can be drained before shutdown
+type InFlightTracker struct {
+	count int64
+}
+
+// NewInFlightTracker - creates a tracker, wrap workers with its WorkerFn to track their triggers
+func NewInFlightTracker() *InFlightTracker {
+	return &InFlightTracker{}
+}
+
+// Count - the number of triggers currently being handled
+func (t *InFlightTracker) Count() int64 {
+	return atomic.LoadInt64(&t.count)
+}
+
+// Wait - blocks until no triggers are being handled, or ctx is done
+func (t *InFlightTracker) Wait(ctx context.Context) error {
+	ticker := time.NewTicker(time.Duration(5) * time.Millisecond)
+	defer ticker.Stop()
+
+	for t.Count() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d triggers still in flight: %w", t.Count(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+func (t *InFlightTracker) track() func() {
+	atomic.AddInt64(&t.count, 1)
+
+	return func() {
+		atomic.AddInt64(&t.count, -1)
+	}
+}
+
+// WorkerFn - wraps the worker to track the triggers it handles, for use with an InstrumentedWorkerPool
+func (t *InFlightTracker) WorkerFn(w Worker) Worker {
+	return &inFlightWorker{
+		Worker:  w,
+		tracker: t,
+	}
+}
+
+type inFlightWorker struct {
+	Worker
+	tracker *InFlightTracker
+}
+
+var _ Worker = &inFlightWorker{}
+
+// Unwrap - returns the tracked worker
+func (w *inFlightWorker) Unwrap() Worker {
+	return w.Worker
+}
+
+func (w *inFlightWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	defer w.tracker.track()()
+
+	return w.Worker.HandleEvent(ctx, trigger)
+}
+
+func (w *inFlightWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	defer w.tracker.track()()
+
+	return w.Worker.HandleHttpRequest(ctx, trigger)
+}
+
+func (w *inFlightWorker) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	wh, ok := w.Worker.(WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle websocket messages")
+	}
+
+	defer w.tracker.track()()
+
+	return wh.HandleWebsocketMessage(ctx, trigger)
+}
+
+func (w *inFlightWorker) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	sh, ok := w.Worker.(ScheduleHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle schedules")
+	}
+
+	defer w.tracker.track()()
+
+	return sh.HandleSchedule(ctx, trigger)
+}
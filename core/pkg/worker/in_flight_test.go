@@ -0,0 +1,62 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mock_worker "github.com/nitrictech/nitric/core/mocks/worker"
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+var _ = Describe("InFlightTracker", func() {
+	When("a trigger is being handled", func() {
+		It("should wait for it to complete", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mw := mock_worker.NewMockWorker(ctrl)
+
+			release := make(chan struct{})
+			mw.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, trigger *triggers.Event) error {
+				<-release
+				return nil
+			})
+
+			tracker := NewInFlightTracker()
+			wrkr := tracker.WorkerFn(mw)
+
+			go func() {
+				defer GinkgoRecover()
+				Expect(wrkr.HandleEvent(context.TODO(), &triggers.Event{})).To(Succeed())
+			}()
+
+			Eventually(tracker.Count).Should(Equal(int64(1)))
+
+			By("timing out while it is in flight")
+			ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+			defer cancel()
+			Expect(tracker.Wait(ctx)).ShouldNot(Succeed())
+
+			By("returning once it has completed")
+			close(release)
+			Expect(tracker.Wait(context.TODO())).To(Succeed())
+			Expect(tracker.Count()).To(Equal(int64(0)))
+		})
+	})
+})
@@ -14,16 +14,51 @@
 
 package worker
 
+import "sync"
+
 type WrappedWorkerFn func(Worker) Worker
 
 type InstrumentedWorkerPool struct {
 	WorkerPool
 	Wrapper WrappedWorkerFn
+
+	wrappedLock sync.Mutex
+	// The wrappers of added workers, so they can be found when removing the original worker
+	wrapped map[Worker]Worker
 }
 
 var _ WorkerPool = &InstrumentedWorkerPool{}
 
 // AddWorker - Adds the given worker to this pool
 func (iwp *InstrumentedWorkerPool) AddWorker(w Worker) error {
-	return iwp.WorkerPool.AddWorker(iwp.Wrapper(w))
+	wrapped := iwp.Wrapper(w)
+
+	if err := iwp.WorkerPool.AddWorker(wrapped); err != nil {
+		return err
+	}
+
+	iwp.wrappedLock.Lock()
+	defer iwp.wrappedLock.Unlock()
+
+	if iwp.wrapped == nil {
+		iwp.wrapped = make(map[Worker]Worker)
+	}
+
+	iwp.wrapped[w] = wrapped
+
+	return nil
+}
+
+// RemoveWorker - Removes the given worker, or its wrapper, from this pool
+func (iwp *InstrumentedWorkerPool) RemoveWorker(w Worker) error {
+	iwp.wrappedLock.Lock()
+	wrapped, ok := iwp.wrapped[w]
+	delete(iwp.wrapped, w)
+	iwp.wrappedLock.Unlock()
+
+	if ok {
+		return iwp.WorkerPool.RemoveWorker(wrapped)
+	}
+
+	return iwp.WorkerPool.RemoveWorker(w)
 }
@@ -0,0 +1,43 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstrumentedWorkerPool", func() {
+	Context("RemoveWorker", func() {
+		When("removing a worker that was wrapped when added", func() {
+			pool := &InstrumentedWorkerPool{
+				WorkerPool: NewProcessPool(&ProcessPoolOptions{}),
+				Wrapper:    InstrumentedWorkerFn,
+			}
+
+			wrkr := NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{
+				Topic: "test",
+			})
+
+			It("should remove its wrapper from the pool", func() {
+				Expect(pool.AddWorker(wrkr)).To(Succeed())
+				Expect(pool.GetWorkerCount()).To(Equal(1))
+
+				Expect(pool.RemoveWorker(wrkr)).To(Succeed())
+				Expect(pool.GetWorkerCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -16,8 +16,11 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
@@ -38,6 +41,11 @@ func InstrumentedWorkerFn(w Worker) Worker {
 	}
 }
 
+// Unwrap - returns the instrumented worker
+func (a *instrumentedWorker) Unwrap() Worker {
+	return a.Worker
+}
+
 // HandleEvent implements worker.Adapter
 func (a *instrumentedWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
 	var s trace.Span
@@ -95,3 +103,64 @@ func (a *instrumentedWorker) HandleHttpRequest(ctx context.Context, trigger *tri
 
 	return resp, err
 }
+
+// HandleWebsocketMessage implements worker.WebsocketHandler
+func (a *instrumentedWorker) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	wh, ok := a.Worker.(WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle websocket messages")
+	}
+
+	var s trace.Span
+
+	ctx, s = otel.Tracer("membrane/pkg/worker", trace.WithInstrumentationVersion(span.MembraneVersion)).
+		Start(ctx, span.Name("websocket-"+trigger.Socket))
+
+	s.SetAttributes(
+		semconv.CodeFunctionKey.String("HandleWebsocketMessage"),
+		attribute.String("websocket.event", trigger.Event.String()),
+		attribute.String("websocket.connection_id", trigger.ConnectionID),
+	)
+
+	defer s.End()
+
+	err := wh.HandleWebsocketMessage(ctx, trigger)
+	if err != nil {
+		s.SetStatus(codes.Error, "Websocket Handler returned an error")
+		s.RecordError(err)
+	} else {
+		s.SetStatus(codes.Ok, "Websocket Message Handled Successfully")
+	}
+
+	return err
+}
+
+// HandleSchedule implements worker.ScheduleHandler
+func (a *instrumentedWorker) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	sh, ok := a.Worker.(ScheduleHandler)
+	if !ok {
+		return fmt.Errorf("worker cannot handle schedules")
+	}
+
+	var s trace.Span
+
+	ctx, s = otel.Tracer("membrane/pkg/worker", trace.WithInstrumentationVersion(span.MembraneVersion)).
+		Start(ctx, span.Name("schedule-"+trigger.Key))
+
+	s.SetAttributes(
+		semconv.CodeFunctionKey.String("HandleSchedule"),
+		attribute.String("schedule.scheduled_time", trigger.ScheduledTime.Format(time.RFC3339)),
+	)
+
+	defer s.End()
+
+	err := sh.HandleSchedule(ctx, trigger)
+	if err != nil {
+		s.SetStatus(codes.Error, "Schedule Handler returned an error")
+		s.RecordError(err)
+	} else {
+		s.SetStatus(codes.Ok, "Schedule Handled Successfully")
+	}
+
+	return err
+}
@@ -16,9 +16,13 @@ package worker
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
+	"github.com/sirupsen/logrus"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/nitrictech/nitric/core/pkg/triggers"
 )
 
@@ -36,6 +40,30 @@ type WorkerPool interface {
 type ProcessPoolOptions struct {
 	MinWorkers int
 	MaxWorkers int
+	// Strategy for selecting between workers able to handle the same trigger, defaults to the first worker
+	Strategy SelectionStrategy
+	// How events are delivered to the workers subscribed to their topic, defaults to all-of
+	Delivery DeliveryMode
+	// Defaults to the default logger
+	Logger *logrus.Entry
+	// The time to wait for replacement workers to register when the pool drops below its minimum,
+	// before Monitor returns an error. Monitor returns immediately if 0.
+	RecoveryWindow time.Duration
+}
+
+// RecoveringError - returned when no worker can handle a trigger while the pool is waiting for replacement workers
+type RecoveringError struct {
+	// The remaining time the pool will wait for replacements
+	RetryAfter time.Duration
+}
+
+func (e *RecoveringError) Error() string {
+	return fmt.Sprintf("workers are recovering, retry after %s", e.RetryAfter)
+}
+
+// RetryAfterSeconds - the time to wait before retrying, rounded up to whole seconds for a Retry-After header
+func (e *RecoveringError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
 }
 
 // ProcessPool - A worker pool that represent co-located processes
@@ -44,7 +72,25 @@ type ProcessPool struct {
 	maxWorkers int
 	workerLock sync.Locker
 	workers    []Worker
+	routes     *Router
+	strategy   SelectionStrategy
+	delivery   DeliveryMode
 	poolErr    chan error
+	log        *logrus.Entry
+
+	recoveryWindow time.Duration
+	// Closed once the pool has recovered its minimum workers, nil if not recovering
+	recovered    chan struct{}
+	recoverUntil time.Time
+}
+
+// logger - returns the pool's logger, or the default logger for pools not created with NewProcessPool
+func (p *ProcessPool) logger() *logrus.Entry {
+	if p.log == nil {
+		return logger.Default()
+	}
+
+	return p.log
 }
 
 func (p *ProcessPool) GetWorkerCount() int {
@@ -53,6 +99,12 @@ func (p *ProcessPool) GetWorkerCount() int {
 	return len(p.workers)
 }
 
+// activeWorkers - returns the workers that are not draining, which are the only workers eligible for new triggers.
+// Must be called with the worker lock held.
+func (p *ProcessPool) activeWorkers() []Worker {
+	return filterWorkers(p.workers, isActive)
+}
+
 func prepend(slice []Worker, elems ...Worker) []Worker {
 	return append(elems, slice...)
 }
@@ -61,8 +113,8 @@ func prepend(slice []Worker, elems ...Worker) []Worker {
 func (p *ProcessPool) getHttpWorkers() []Worker {
 	hws := make([]Worker, 0)
 
-	for _, w := range p.workers {
-		switch w.(type) {
+	for _, w := range p.activeWorkers() {
+		switch BaseWorker(w).(type) {
 		case *ScheduleWorker:
 			break
 		case *SubscriptionWorker:
@@ -83,8 +135,8 @@ func (p *ProcessPool) getHttpWorkers() []Worker {
 func (p *ProcessPool) getEventWorkers() []Worker {
 	hws := make([]Worker, 0)
 
-	for _, w := range p.workers {
-		switch w.(type) {
+	for _, w := range p.activeWorkers() {
+		switch BaseWorker(w).(type) {
 		case *RouteWorker:
 			// Ignore route workers
 			break
@@ -144,9 +196,15 @@ func (p *ProcessPool) WaitForMinimumWorkers(timeout int) error {
 }
 
 type GetWorkerOptions struct {
-	Http   *triggers.HttpRequest
-	Event  *triggers.Event
-	Filter func(w Worker) bool
+	Http      *triggers.HttpRequest
+	Event     *triggers.Event
+	Websocket *triggers.WebsocketMessage
+	Schedule  *triggers.Schedule
+	Filter    func(w Worker) bool
+	// Strategy overrides the pool's strategy for selecting between workers able to handle the trigger
+	Strategy SelectionStrategy
+	// Delivery overrides the pool's delivery mode for the subscribers of an event returned by GetWorkers
+	Delivery DeliveryMode
 }
 
 func filterWorkers(ws []Worker, f func(w Worker) bool) []Worker {
@@ -160,13 +218,13 @@ func filterWorkers(ws []Worker, f func(w Worker) bool) []Worker {
 	return newWs
 }
 
-// GetWorkers - return a slice of all workers matching the input options.
+// GetWorkers - return a slice of all workers matching the input options, excluding draining workers.
 // useful for retrieving a list of all topic subscribers (for example)
 func (p *ProcessPool) GetWorkers(opts *GetWorkerOptions) []Worker {
 	p.workerLock.Lock()
 	defer p.workerLock.Unlock()
 
-	workers := append([]Worker{}, p.workers...)
+	workers := p.activeWorkers()
 
 	if opts.Http != nil {
 		workers = filterWorkers(workers, func(w Worker) bool {
@@ -180,29 +238,135 @@ func (p *ProcessPool) GetWorkers(opts *GetWorkerOptions) []Worker {
 		})
 	}
 
+	if opts.Websocket != nil {
+		workers = filterWorkers(workers, func(w Worker) bool {
+			return handlesWebsocketMessage(w, opts.Websocket)
+		})
+	}
+
+	if opts.Schedule != nil {
+		workers = filterWorkers(workers, func(w Worker) bool {
+			return handlesSchedule(w, opts.Schedule)
+		})
+	}
+
 	if opts.Filter != nil {
 		workers = filterWorkers(workers, opts.Filter)
 	}
 
+	if opts.Event != nil && p.deliveryMode(opts) == DeliveryOneOf {
+		workers = p.oneOfSubscribers(opts, workers)
+	}
+
 	return workers
 }
 
-// GetWorker - Retrieves a worker from this pool
+// deliveryMode - returns the requested delivery mode, or the pool's delivery mode if none was requested
+func (p *ProcessPool) deliveryMode(opts *GetWorkerOptions) DeliveryMode {
+	if opts.Delivery != "" {
+		return opts.Delivery
+	}
+
+	if p.delivery != "" {
+		return p.delivery
+	}
+
+	return DeliveryAllOf
+}
+
+// handlesWebsocketMessage - returns true if the worker is a websocket worker for the message's socket and event
+func handlesWebsocketMessage(w Worker, trigger *triggers.WebsocketMessage) bool {
+	ww, ok := BaseWorker(w).(*WebsocketWorker)
+
+	return ok && ww.HandlesWebsocketMessage(trigger)
+}
+
+// handlesSchedule - returns true if the worker is a schedule worker for the schedule's key
+func handlesSchedule(w Worker, trigger *triggers.Schedule) bool {
+	sw, ok := BaseWorker(w).(*ScheduleWorker)
+
+	return ok && sw.HandlesSchedule(trigger)
+}
+
+// isSpecialised - returns true for workers registered for specific routes, topics or schedules
+func isSpecialised(w Worker) bool {
+	switch BaseWorker(w).(type) {
+	case *RouteWorker, *SubscriptionWorker, *ScheduleWorker, *WebsocketWorker:
+		return true
+	default:
+		return false
+	}
+}
+
+// preferSpecialised - narrows candidates to specialised workers if there are any
+func preferSpecialised(candidates []Worker) []Worker {
+	specialised := filterWorkers(candidates, isSpecialised)
+	if len(specialised) > 0 {
+		return specialised
+	}
+
+	return candidates
+}
+
+// selectWorker - selects one of the candidates using the requested strategy, or the pool's strategy if none was requested.
+// Candidates at their maximum concurrency are only selected if all of them are, in which case the trigger will wait for a free slot.
+func (p *ProcessPool) selectWorker(opts *GetWorkerOptions, candidates []Worker) Worker {
+	if available := filterWorkers(candidates, workerHasCapacity); len(available) > 0 {
+		candidates = available
+	}
+
+	strategy := opts.Strategy
+	if strategy == nil {
+		strategy = p.strategy
+	}
+
+	if strategy == nil {
+		strategy = &FirstStrategy{}
+	}
+
+	return strategy.Select(candidates)
+}
+
+// GetWorker - Retrieves a single worker from this pool, selected by strategy when several workers can handle the trigger.
+// To deliver a trigger to all of the workers able to handle it use GetWorkers instead.
 func (p *ProcessPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
 	p.workerLock.Lock()
 	defer p.workerLock.Unlock()
 
+	var routeErr error
+
 	if opts.Http != nil {
+		if p.routes != nil {
+			var ws []Worker
+			ws, routeErr = p.routes.Lookup(opts.Http)
+			ws = filterWorkers(ws, isActive)
+
+			if opts.Filter != nil {
+				ws = filterWorkers(ws, opts.Filter)
+			}
+
+			if len(ws) > 0 {
+				return p.selectWorker(opts, ws), nil
+			}
+		}
+
 		ws := p.getHttpWorkers()
 
 		if opts.Filter != nil {
 			ws = filterWorkers(ws, opts.Filter)
 		}
 
-		for _, w := range ws {
-			if w.HandlesHttpRequest(opts.Http) {
-				return w, nil
+		ws = filterWorkers(ws, func(w Worker) bool {
+			// Indexed route workers have already been matched by the router
+			if _, ok := asRouteWorker(w); ok && p.routes != nil {
+				return false
 			}
+
+			return w.HandlesHttpRequest(opts.Http)
+		})
+
+		if len(ws) > 0 {
+			return p.selectWorker(opts, preferSpecialised(ws)), nil
 		}
 	}
 
@@ -213,16 +377,79 @@ func (p *ProcessPool) GetWorker(opts *GetWorkerOptions) (Worker, error) {
 			ws = filterWorkers(ws, opts.Filter)
 		}
 
-		for _, w := range ws {
-			if w.HandlesEvent(opts.Event) {
-				return w, nil
-			}
+		ws = filterWorkers(ws, func(w Worker) bool {
+			return w.HandlesEvent(opts.Event)
+		})
+
+		if len(ws) > 0 {
+			return p.selectWorker(opts, preferSpecialised(ws)), nil
 		}
 	}
 
+	if opts.Websocket != nil {
+		ws := filterWorkers(p.activeWorkers(), func(w Worker) bool {
+			return handlesWebsocketMessage(w, opts.Websocket)
+		})
+
+		if opts.Filter != nil {
+			ws = filterWorkers(ws, opts.Filter)
+		}
+
+		if len(ws) > 0 {
+			return p.selectWorker(opts, ws), nil
+		}
+	}
+
+	if opts.Schedule != nil {
+		ws := filterWorkers(p.activeWorkers(), func(w Worker) bool {
+			return handlesSchedule(w, opts.Schedule)
+		})
+
+		if opts.Filter != nil {
+			ws = filterWorkers(ws, opts.Filter)
+		}
+
+		if len(ws) > 0 {
+			return p.selectWorker(opts, ws), nil
+		}
+	}
+
+	// The worker able to handle the trigger may be about to reconnect
+	if err := p.recoveringError(); err != nil {
+		return nil, err
+	}
+
+	// The workers able to handle the trigger are leaving the pool, and may be replaced
+	if p.drainingHandles(opts) {
+		return nil, ErrDraining
+	}
+
+	if routeErr != nil {
+		return nil, fmt.Errorf("no valid workers available: %w", routeErr)
+	}
+
 	return nil, fmt.Errorf("no valid workers available")
 }
 
+// drainingHandles - returns true if a draining worker is able to handle the trigger.
+// Must be called with the worker lock held.
+func (p *ProcessPool) drainingHandles(opts *GetWorkerOptions) bool {
+	for _, w := range p.workers {
+		if isActive(w) {
+			continue
+		}
+
+		if (opts.Http != nil && w.HandlesHttpRequest(opts.Http)) ||
+			(opts.Event != nil && w.HandlesEvent(opts.Event)) ||
+			(opts.Websocket != nil && handlesWebsocketMessage(w, opts.Websocket)) ||
+			(opts.Schedule != nil && handlesSchedule(w, opts.Schedule)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RemoveWorker - Removes the given worker from this pool
 func (p *ProcessPool) RemoveWorker(wrkr Worker) error {
 	p.workerLock.Lock()
@@ -231,8 +458,21 @@ func (p *ProcessPool) RemoveWorker(wrkr Worker) error {
 	for i, w := range p.workers {
 		if wrkr == w {
 			p.workers = append(p.workers[:i], p.workers[i+1:]...)
+			if rw, ok := asRouteWorker(w); ok && p.routes != nil {
+				p.routes.Remove(w, rw)
+			}
+
+			p.logger().WithFields(logger.Fields{
+				logger.WorkerTypeKey: TypeOf(w),
+				"workers":            len(p.workers),
+			}).Info("worker removed")
+
 			if len(p.workers) < p.minWorkers {
-				p.poolErr <- fmt.Errorf("insufficient workers in pool, need minimum of %d, %d available", p.minWorkers, len(p.workers))
+				if p.recoveryWindow > 0 {
+					p.startRecovery()
+				} else {
+					p.poolErr <- fmt.Errorf("insufficient workers in pool, need minimum of %d, %d available", p.minWorkers, len(p.workers))
+				}
 			}
 
 			return nil
@@ -255,21 +495,111 @@ func (p *ProcessPool) AddWorker(wrkr Worker) error {
 	}
 
 	p.workers = append(p.workers, wrkr)
+	if rw, ok := asRouteWorker(wrkr); ok && p.routes != nil {
+		p.routes.Add(wrkr, rw)
+	}
+
+	p.logger().WithFields(logger.Fields{
+		logger.WorkerTypeKey: TypeOf(wrkr),
+		"workers":            len(p.workers),
+	}).Info("worker added")
+
+	if p.recovered != nil && len(p.workers) >= p.minWorkers {
+		close(p.recovered)
+		p.recovered = nil
+
+		p.logger().WithField("workers", len(p.workers)).Info("pool recovered minimum workers")
+	}
 
 	return nil
 }
 
+// startRecovery - waits for replacement workers in the background, returning an error from Monitor if they don't register within the recovery window.
+// Must be called with the worker lock held.
+func (p *ProcessPool) startRecovery() {
+	if p.recovered != nil {
+		// Already recovering
+		return
+	}
+
+	recovered := make(chan struct{})
+	p.recovered = recovered
+	p.recoverUntil = time.Now().Add(p.recoveryWindow)
+
+	p.logger().WithFields(logger.Fields{
+		"workers":         len(p.workers),
+		"min_workers":     p.minWorkers,
+		"recovery_window": p.recoveryWindow.String(),
+	}).Warn("workers below minimum, waiting for replacements")
+
+	go func() {
+		timer := time.NewTimer(p.recoveryWindow)
+		defer timer.Stop()
+
+		select {
+		case <-recovered:
+			return
+		case <-timer.C:
+		}
+
+		p.workerLock.Lock()
+		available := len(p.workers)
+		stillRecovering := p.recovered == recovered
+		p.workerLock.Unlock()
+
+		if !stillRecovering {
+			// Recovered as the window ended
+			return
+		}
+
+		p.poolErr <- fmt.Errorf("insufficient workers in pool, need minimum of %d, %d available after waiting %s for replacements", p.minWorkers, available, p.recoveryWindow)
+	}()
+}
+
+// recoveringError - returns a RecoveringError if the pool is waiting for replacement workers, otherwise nil.
+// Must be called with the worker lock held.
+func (p *ProcessPool) recoveringError() error {
+	if p.recovered == nil {
+		return nil
+	}
+
+	retryAfter := time.Until(p.recoverUntil)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+
+	return &RecoveringError{RetryAfter: retryAfter}
+}
+
 // NewProcessPool - Creates a new process pool
 func NewProcessPool(opts *ProcessPoolOptions) WorkerPool {
 	if opts.MaxWorkers < 1 {
 		opts.MaxWorkers = 1
 	}
 
+	if opts.Strategy == nil {
+		opts.Strategy = &FirstStrategy{}
+	}
+
+	if opts.Delivery == "" {
+		opts.Delivery = DeliveryAllOf
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = logger.Default()
+	}
+
 	return &ProcessPool{
 		minWorkers: opts.MinWorkers,
 		maxWorkers: opts.MaxWorkers,
 		workerLock: &sync.Mutex{},
 		workers:    make([]Worker, 0),
+		routes:     NewRouter(),
+		strategy:   opts.Strategy,
+		delivery:   opts.Delivery,
 		poolErr:    make(chan error),
+		log:        opts.Logger.WithField(logger.ComponentKey, "pool"),
+
+		recoveryWindow: opts.RecoveryWindow,
 	}
 }
@@ -15,8 +15,10 @@
 package worker
 
 import (
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -231,6 +233,31 @@ var _ = Describe("ProcessPool", func() {
 				})
 			})
 
+			Context("Getting a worker for a routed Http trigger", func() {
+				pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+				rw := InstrumentedWorkerFn(NewRouteWorker(nil, &RouteWorkerOptions{
+					Api:     "main",
+					Path:    "/users/:id",
+					Methods: []string{"GET"},
+				}))
+				Expect(pp.AddWorker(rw)).To(Succeed())
+
+				When("the route matches", func() {
+					It("should return the indexed worker", func() {
+						wrkr, err := pp.GetWorker(&GetWorkerOptions{Http: &triggers.HttpRequest{Method: "GET", Path: "/users/123"}})
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(wrkr).To(Equal(rw))
+					})
+				})
+
+				When("only the path matches", func() {
+					It("should return ErrMethodNotAllowed", func() {
+						_, err := pp.GetWorker(&GetWorkerOptions{Http: &triggers.HttpRequest{Method: "POST", Path: "/users/123"}})
+						Expect(errors.Is(err, ErrMethodNotAllowed)).To(BeTrue())
+					})
+				})
+			})
+
 			Context("Getting a worker for an Event trigger", func() {
 				When("no compatible event workers are available", func() {
 					When("no compatible workers are available", func() {
@@ -328,6 +355,59 @@ var _ = Describe("ProcessPool", func() {
 			})
 		})
 
+		Context("RecoveryWindow", func() {
+			var pool WorkerPool
+			var wrkr Worker
+
+			BeforeEach(func() {
+				pool = NewProcessPool(&ProcessPoolOptions{
+					MinWorkers:     1,
+					MaxWorkers:     2,
+					RecoveryWindow: 200 * time.Millisecond,
+				})
+				wrkr = NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "test"})
+				Expect(pool.AddWorker(wrkr)).To(Succeed())
+			})
+
+			When("the pool drops below its minimum workers", func() {
+				It("should reject triggers with a RecoveringError", func() {
+					Expect(pool.RemoveWorker(wrkr)).To(Succeed())
+
+					_, err := pool.GetWorker(&GetWorkerOptions{Event: &triggers.Event{Topic: "test"}})
+
+					var recoveringErr *RecoveringError
+					Expect(errors.As(err, &recoveringErr)).To(BeTrue())
+					Expect(recoveringErr.RetryAfterSeconds()).To(Equal(1))
+				})
+
+				It("should not fail if a replacement registers within the window", func() {
+					monitorErr := make(chan error, 1)
+					go func(pool WorkerPool) {
+						monitorErr <- pool.Monitor()
+					}(pool)
+
+					Expect(pool.RemoveWorker(wrkr)).To(Succeed())
+					Expect(pool.AddWorker(NewSubscriptionWorker(nil, &SubscriptionWorkerOptions{Topic: "test"}))).To(Succeed())
+
+					Consistently(monitorErr, 400*time.Millisecond).ShouldNot(Receive())
+
+					_, err := pool.GetWorker(&GetWorkerOptions{Event: &triggers.Event{Topic: "test"}})
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+
+				It("should fail once the window expires", func() {
+					monitorErr := make(chan error, 1)
+					go func(pool WorkerPool) {
+						monitorErr <- pool.Monitor()
+					}(pool)
+
+					Expect(pool.RemoveWorker(wrkr)).To(Succeed())
+
+					Eventually(monitorErr).Should(Receive(MatchError(ContainSubstring("insufficient workers in pool"))))
+				})
+			})
+		})
+
 		Context("AddWorker", func() {
 			When("Max workers have not been exceeded", func() {
 				ctrl := gomock.NewController(GinkgoT())
@@ -16,6 +16,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -23,12 +24,22 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/utils"
 )
 
+// Authorizer - validates that a http request satisfies the security requirements of an api route
+type Authorizer interface {
+	// Authorize - returns the claims of the validated caller, errors implementing StatusCode() int are returned to the caller with that status
+	Authorize(ctx context.Context, api string, requirements map[string][]string, header map[string][]string) (map[string]interface{}, error)
+}
+
 // RouteWorker - Worker representation for an http api route handler
 type RouteWorker struct {
 	api     string
 	methods []string
 	path    string
 
+	security         map[string][]string
+	securityDisabled bool
+	authorizer       Authorizer
+
 	Adapter
 }
 
@@ -40,24 +51,39 @@ func (s *RouteWorker) Api() string {
 	return s.api
 }
 
+// Path - Retrieve the path template this
+// route worker was registered for
+func (s *RouteWorker) Path() string {
+	return s.path
+}
+
 func (s *RouteWorker) extractPathParams(trigger *triggers.HttpRequest) (map[string]string, error) {
 	requestPathSegments := utils.SplitPath(trigger.Path)
 	pathSegments := utils.SplitPath(s.path)
 	params := make(map[string]string)
 
-	// TODO: Filter for trailing/leading slashes
-	if len(requestPathSegments) != len(pathSegments) {
-		return nil, fmt.Errorf("path template mismatch")
-	}
-
 	for i, p := range pathSegments {
-		if !strings.HasPrefix(p, ":") && p != requestPathSegments[i] {
+		// wildcards capture all remaining segments
+		if strings.HasPrefix(p, "*") {
+			params[strings.TrimPrefix(p, "*")] = strings.Join(requestPathSegments[i:], "/")
+			return params, nil
+		}
+
+		if i >= len(requestPathSegments) {
 			return nil, fmt.Errorf("path template mismatch")
-		} else if strings.HasPrefix(p, ":") {
+		}
+
+		if strings.HasPrefix(p, ":") {
 			params[strings.Replace(p, ":", "", 1)] = requestPathSegments[i]
+		} else if p != requestPathSegments[i] {
+			return nil, fmt.Errorf("path template mismatch")
 		}
 	}
 
+	if len(requestPathSegments) != len(pathSegments) {
+		return nil, fmt.Errorf("path template mismatch")
+	}
+
 	return params, nil
 }
 
@@ -72,6 +98,11 @@ func (s *RouteWorker) hasMethod(method string) bool {
 }
 
 func (s *RouteWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	// Requests tagged with an API by the gateway only match routes of that API
+	if trigger.Api != "" && trigger.Api != s.api {
+		return false
+	}
+
 	if !s.hasMethod(trigger.Method) {
 		return false
 	}
@@ -92,6 +123,26 @@ func (s *RouteWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.H
 	}
 
 	trigger.Params = params
+	trigger.Api = s.api
+	trigger.Security = s.security
+	trigger.SecurityDisabled = s.securityDisabled
+
+	if s.authorizer != nil && !s.securityDisabled {
+		claims, err := s.authorizer.Authorize(ctx, s.api, s.security, trigger.Header)
+		if err != nil {
+			var statusErr interface{ StatusCode() int }
+			if errors.As(err, &statusErr) {
+				return &triggers.HttpResponse{
+					StatusCode: statusErr.StatusCode(),
+					Body:       []byte(err.Error()),
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		trigger.Claims = claims
+	}
 
 	return s.Adapter.HandleHttpRequest(ctx, trigger)
 }
@@ -104,6 +155,12 @@ type RouteWorkerOptions struct {
 	Api     string
 	Path    string
 	Methods []string
+	// Security requirements for this route, mapping security definition names to required scopes
+	Security map[string][]string
+	// Explicitly disable security for this route, overriding the api's root level security
+	SecurityDisabled bool
+	// Validates requests against the route's security, security is not enforced if nil
+	Authorizer Authorizer
 }
 
 // Package private method
@@ -113,6 +170,11 @@ func NewRouteWorker(adapter Adapter, opts *RouteWorkerOptions) *RouteWorker {
 		api:     opts.Api,
 		path:    opts.Path,
 		methods: opts.Methods,
+
+		security:         opts.Security,
+		securityDisabled: opts.SecurityDisabled,
+		authorizer:       opts.Authorizer,
+
 		Adapter: adapter,
 	}
 }
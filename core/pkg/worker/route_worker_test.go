@@ -28,6 +28,7 @@ import (
 var _ = Describe("RouteWorker", func() {
 	Context("Http", func() {
 		rWrkr := &RouteWorker{
+			api:     "main",
 			methods: []string{"GET"},
 			path:    "/test/:param",
 		}
@@ -59,6 +60,42 @@ var _ = Describe("RouteWorker", func() {
 			})
 		})
 
+		When("calling HandlesHttpRequest with a request for another api", func() {
+			It("should return false", func() {
+				Expect(rWrkr.HandlesHttpRequest(&triggers.HttpRequest{
+					Api:    "other",
+					Method: "GET",
+					Path:   "/test/test",
+				})).To(BeFalse())
+			})
+		})
+
+		When("calling HandlesHttpRequest with a request for its own api", func() {
+			It("should return true", func() {
+				Expect(rWrkr.HandlesHttpRequest(&triggers.HttpRequest{
+					Api:    "main",
+					Method: "GET",
+					Path:   "/test/test",
+				})).To(BeTrue())
+			})
+		})
+
+		When("calling HandlesHttpRequest with a wildcard route", func() {
+			wWrkr := &RouteWorker{
+				methods: []string{"GET"},
+				path:    "/files/*rest",
+			}
+
+			It("should capture the remaining path segments", func() {
+				params, err := wWrkr.extractPathParams(&triggers.HttpRequest{
+					Method: "GET",
+					Path:   "/files/a/b.txt",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(params).To(Equal(map[string]string{"rest": "a/b.txt"}))
+			})
+		})
+
 		When("calling HandleHttpRequest", func() {
 			It("should call the base grpc workers HandleEvent with augmented trigger", func() {
 				ctrl := gomock.NewController(GinkgoT())
@@ -0,0 +1,223 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+	"github.com/nitrictech/nitric/core/pkg/utils"
+)
+
+var (
+	// ErrRouteNotFound - no route matches the path of the request
+	ErrRouteNotFound = errors.New("no route matches the request path")
+	// ErrMethodNotAllowed - a route matches the path of the request, but not its method
+	ErrMethodNotAllowed = errors.New("method not allowed for the request path")
+)
+
+// route - a worker registered for a method on a route template
+type route struct {
+	api    string
+	worker Worker
+}
+
+// routeNode - a node in the route tree, representing a single segment of a path template
+type routeNode struct {
+	// static child segments, matched exactly
+	static map[string]*routeNode
+	// child matching any single segment, for :param segments
+	param *routeNode
+	// child matching all remaining segments, for *rest segments
+	wildcard *routeNode
+	// workers for routes ending at this node, by method
+	routes map[string][]*route
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		static: make(map[string]*routeNode),
+		routes: make(map[string][]*route),
+	}
+}
+
+func (n *routeNode) empty() bool {
+	return len(n.static) == 0 && n.param == nil && n.wildcard == nil && len(n.routes) == 0
+}
+
+// child - returns the child node for the given template segment, creating it if create is true
+func (n *routeNode) child(segment string, create bool) *routeNode {
+	var next **routeNode
+
+	switch {
+	case strings.HasPrefix(segment, ":"):
+		next = &n.param
+	case strings.HasPrefix(segment, "*"):
+		next = &n.wildcard
+	default:
+		if n.static[segment] == nil && create {
+			n.static[segment] = newRouteNode()
+		}
+
+		return n.static[segment]
+	}
+
+	if *next == nil && create {
+		*next = newRouteNode()
+	}
+
+	return *next
+}
+
+// remove - removes the worker from the node at the end of the template segments, pruning nodes left empty
+func (n *routeNode) remove(segments []string, w Worker) bool {
+	if len(segments) == 0 {
+		removed := false
+
+		for method, routes := range n.routes {
+			for i, r := range routes {
+				if r.worker == w {
+					n.routes[method] = append(routes[:i], routes[i+1:]...)
+					removed = true
+					break
+				}
+			}
+
+			if len(n.routes[method]) == 0 {
+				delete(n.routes, method)
+			}
+		}
+
+		return removed
+	}
+
+	next := n.child(segments[0], false)
+	if next == nil {
+		return false
+	}
+
+	removed := next.remove(segments[1:], w)
+
+	if next.empty() {
+		switch {
+		case next == n.param:
+			n.param = nil
+		case next == n.wildcard:
+			n.wildcard = nil
+		default:
+			delete(n.static, segments[0])
+		}
+	}
+
+	return removed
+}
+
+// match - visits each node matching the path segments, in order of precedence, until visit returns true.
+// Static segments take precedence over params, which take precedence over wildcards.
+func (n *routeNode) match(segments []string, visit func(*routeNode) bool) bool {
+	if len(segments) == 0 {
+		if visit(n) {
+			return true
+		}
+	} else {
+		if next, ok := n.static[segments[0]]; ok && next.match(segments[1:], visit) {
+			return true
+		}
+
+		if n.param != nil && n.param.match(segments[1:], visit) {
+			return true
+		}
+	}
+
+	// wildcards match all remaining segments, including none
+	return n.wildcard != nil && visit(n.wildcard)
+}
+
+// Router - An index of route workers, as a radix tree of path template segments.
+// Routers are not thread safe, callers are expected to synchronise access.
+type Router struct {
+	root *routeNode
+}
+
+func routeMatchesApi(r *route, api string) bool {
+	return api == "" || r.api == api
+}
+
+// Add - indexes the worker by the methods and path of its route
+func (r *Router) Add(w Worker, rw *RouteWorker) {
+	n := r.root
+	for _, segment := range utils.SplitPath(rw.path) {
+		n = n.child(segment, true)
+	}
+
+	for _, method := range rw.methods {
+		n.routes[method] = append(n.routes[method], &route{api: rw.api, worker: w})
+	}
+}
+
+// Remove - removes the worker from the index, returning false if it was not indexed
+func (r *Router) Remove(w Worker, rw *RouteWorker) bool {
+	return r.root.remove(utils.SplitPath(rw.path), w)
+}
+
+// Lookup - returns the workers for the most specific route matching the request.
+// Returns ErrMethodNotAllowed if routes match the request path but not its method, otherwise ErrRouteNotFound.
+func (r *Router) Lookup(trigger *triggers.HttpRequest) ([]Worker, error) {
+	var workers []Worker
+	pathMatched := false
+
+	r.root.match(utils.SplitPath(trigger.Path), func(n *routeNode) bool {
+		for method, routes := range n.routes {
+			for _, rt := range routes {
+				if !routeMatchesApi(rt, trigger.Api) {
+					continue
+				}
+
+				pathMatched = true
+
+				if method == trigger.Method {
+					workers = append(workers, rt.worker)
+				}
+			}
+		}
+
+		return len(workers) > 0
+	})
+
+	if len(workers) > 0 {
+		return workers, nil
+	}
+
+	if pathMatched {
+		return nil, ErrMethodNotAllowed
+	}
+
+	return nil, ErrRouteNotFound
+}
+
+// NewRouter - Creates a new empty router
+func NewRouter() *Router {
+	return &Router{
+		root: newRouteNode(),
+	}
+}
+
+// asRouteWorker - returns the route worker underlying the given worker, if any
+func asRouteWorker(w Worker) (*RouteWorker, bool) {
+	rw, ok := BaseWorker(w).(*RouteWorker)
+
+	return rw, ok
+}
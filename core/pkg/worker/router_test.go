@@ -0,0 +1,114 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+func addRoute(r *Router, api string, path string, methods ...string) *RouteWorker {
+	rw := &RouteWorker{api: api, path: path, methods: methods}
+	r.Add(rw, rw)
+
+	return rw
+}
+
+var _ = Describe("Router", func() {
+	Context("Lookup", func() {
+		router := NewRouter()
+		static := addRoute(router, "main", "/users/me", "GET")
+		param := addRoute(router, "main", "/users/:id", "GET", "DELETE")
+		wildcard := addRoute(router, "main", "/files/*rest", "GET")
+		other := addRoute(router, "other", "/users/:id", "POST")
+
+		When("a static and a param route match the path", func() {
+			It("should prefer the static route", func() {
+				ws, err := router.Lookup(&triggers.HttpRequest{Method: "GET", Path: "/users/me"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ws).To(Equal([]Worker{static}))
+			})
+		})
+
+		When("the static route does not handle the method", func() {
+			It("should fall back to the param route", func() {
+				ws, err := router.Lookup(&triggers.HttpRequest{Method: "DELETE", Path: "/users/me"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ws).To(Equal([]Worker{param}))
+			})
+		})
+
+		When("the path has a trailing slash", func() {
+			It("should match the route without it", func() {
+				ws, err := router.Lookup(&triggers.HttpRequest{Method: "GET", Path: "/users/123/"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ws).To(Equal([]Worker{param}))
+			})
+		})
+
+		When("the path matches a wildcard route", func() {
+			It("should match any number of remaining segments", func() {
+				ws, err := router.Lookup(&triggers.HttpRequest{Method: "GET", Path: "/files/a/b/c.txt"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ws).To(Equal([]Worker{wildcard}))
+			})
+		})
+
+		When("the request is tagged with an api", func() {
+			It("should only match routes of that api", func() {
+				ws, err := router.Lookup(&triggers.HttpRequest{Api: "other", Method: "POST", Path: "/users/123"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ws).To(Equal([]Worker{other}))
+
+				_, err = router.Lookup(&triggers.HttpRequest{Api: "other", Method: "GET", Path: "/users/me"})
+				Expect(err).To(Equal(ErrMethodNotAllowed))
+			})
+		})
+
+		When("the path matches but the method does not", func() {
+			It("should return ErrMethodNotAllowed", func() {
+				_, err := router.Lookup(&triggers.HttpRequest{Method: "PUT", Path: "/users/123"})
+				Expect(err).To(Equal(ErrMethodNotAllowed))
+			})
+		})
+
+		When("no route matches the path", func() {
+			It("should return ErrRouteNotFound", func() {
+				_, err := router.Lookup(&triggers.HttpRequest{Method: "GET", Path: "/teams/123"})
+				Expect(err).To(Equal(ErrRouteNotFound))
+			})
+		})
+	})
+
+	Context("Remove", func() {
+		router := NewRouter()
+		rw := addRoute(router, "main", "/users/:id", "GET")
+
+		It("should no longer match the removed route", func() {
+			Expect(router.Remove(rw, rw)).To(BeTrue())
+
+			_, err := router.Lookup(&triggers.HttpRequest{Method: "GET", Path: "/users/123"})
+			Expect(err).To(Equal(ErrRouteNotFound))
+			Expect(router.root.empty()).To(BeTrue())
+		})
+
+		It("should return false for workers that are not indexed", func() {
+			unindexed := &RouteWorker{path: "/users/:id", methods: []string{"GET"}}
+			Expect(router.Remove(unindexed, unindexed)).To(BeFalse())
+		})
+	})
+})
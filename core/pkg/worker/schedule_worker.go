@@ -18,17 +18,36 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/nitrictech/nitric/core/pkg/triggers"
 )
 
-// RouteWorker - Worker representation for an http api route handler
+// ScheduleHandler - Handles the firing of schedules
+type ScheduleHandler interface {
+	HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error
+}
+
+// ScheduleTriggerSupporter - Implemented by adapters for workers that may predate schedule triggers
+type ScheduleTriggerSupporter interface {
+	SupportsScheduleTriggers() bool
+}
+
+// ScheduleWorker - Worker representation for a schedule handler
 type ScheduleWorker struct {
 	key string
+	// The cadence of the schedule, at most one is set
+	rate string
+	cron string
 	Adapter
 }
 
-var _ Worker = &ScheduleWorker{}
+var (
+	_ Worker          = &ScheduleWorker{}
+	_ ScheduleHandler = &ScheduleWorker{}
+)
 
 func (s *ScheduleWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
 	return false
@@ -44,10 +63,79 @@ func (s *ScheduleWorker) Key() string {
 	return s.key
 }
 
+// Rate - the rate the schedule fires at, e.g. "5 minutes", empty if the schedule has a cron expression
+func (s *ScheduleWorker) Rate() string {
+	return s.rate
+}
+
+// Cron - the cron expression of the schedule, empty if the schedule has a rate
+func (s *ScheduleWorker) Cron() string {
+	return s.cron
+}
+
+// HandlesSchedule - returns true if the worker was registered for the schedule's key
+func (s *ScheduleWorker) HandlesSchedule(trigger *triggers.Schedule) bool {
+	return s.key == trigger.Key
+}
+
+// scheduleHandler - returns the adapter's schedule handler, if its worker supports schedule triggers
+func (s *ScheduleWorker) scheduleHandler() (ScheduleHandler, bool) {
+	sh, ok := s.Adapter.(ScheduleHandler)
+	if !ok {
+		return nil, false
+	}
+
+	if sts, ok := s.Adapter.(ScheduleTriggerSupporter); ok && !sts.SupportsScheduleTriggers() {
+		return nil, false
+	}
+
+	return sh, true
+}
+
+// HandleSchedule - delivers the firing of the schedule, as an event to the topic named after its key
+// if the worker does not support schedule triggers
+func (s *ScheduleWorker) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	sh, ok := s.scheduleHandler()
+	if !ok {
+		return s.Adapter.HandleEvent(ctx, &triggers.Event{
+			ID:          uuid.New().String(),
+			Topic:       ScheduleKeyToTopicName(s.key),
+			PublishTime: trigger.FireTime,
+		})
+	}
+
+	if trigger.Cadence == "" {
+		withCadence := *trigger
+		withCadence.Cadence = s.rate + s.cron
+		trigger = &withCadence
+	}
+
+	return sh.HandleSchedule(ctx, trigger)
+}
+
+// HandlesEvent - schedules published as events to the topic named after their key, by deployments predating schedule triggers
 func (s *ScheduleWorker) HandlesEvent(trigger *triggers.Event) bool {
 	return ScheduleKeyToTopicName(s.key) == trigger.Topic
 }
 
+// HandleEvent - delivers a schedule published as an event, as a firing of the schedule if the worker supports schedule triggers
+func (s *ScheduleWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	if _, ok := s.scheduleHandler(); !ok {
+		return s.Adapter.HandleEvent(ctx, trigger)
+	}
+
+	fireTime := trigger.PublishTime
+	if fireTime.IsZero() {
+		fireTime = time.Now()
+	}
+
+	return s.HandleSchedule(ctx, &triggers.Schedule{
+		Key:           s.key,
+		ScheduledTime: fireTime,
+		FireTime:      fireTime,
+	})
+}
+
 func (s *ScheduleWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
 	// Generate an ID here
 	return nil, fmt.Errorf("schedule workers cannot handle HTTP requests")
@@ -55,6 +143,9 @@ func (s *ScheduleWorker) HandleHttpRequest(ctx context.Context, trigger *trigger
 
 type ScheduleWorkerOptions struct {
 	Key string
+	// The cadence of the schedule, used to fire it when the membrane runs schedules itself
+	Rate string
+	Cron string
 }
 
 // Package private method
@@ -62,6 +153,8 @@ type ScheduleWorkerOptions struct {
 func NewScheduleWorker(adapter Adapter, opts *ScheduleWorkerOptions) *ScheduleWorker {
 	return &ScheduleWorker{
 		key:     opts.Key,
+		rate:    opts.Rate,
+		cron:    opts.Cron,
 		Adapter: adapter,
 	}
 }
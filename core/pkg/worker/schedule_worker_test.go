@@ -0,0 +1,100 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// scheduleTriggerAdapter - records the triggers delivered to a worker that may support schedule triggers
+type scheduleTriggerAdapter struct {
+	supported bool
+	events    []*triggers.Event
+	schedules []*triggers.Schedule
+}
+
+func (a *scheduleTriggerAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	a.events = append(a.events, trigger)
+	return nil
+}
+
+func (a *scheduleTriggerAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("unexpected http request")
+}
+
+func (a *scheduleTriggerAdapter) HandleSchedule(ctx context.Context, trigger *triggers.Schedule) error {
+	a.schedules = append(a.schedules, trigger)
+	return nil
+}
+
+func (a *scheduleTriggerAdapter) SupportsScheduleTriggers() bool {
+	return a.supported
+}
+
+var _ = Describe("ScheduleWorker", func() {
+	publishTime := time.Date(2022, time.January, 3, 10, 0, 0, 0, time.UTC)
+	legacyEvent := &triggers.Event{ID: "1", Topic: "nightly-cleanup", PublishTime: publishTime}
+
+	When("the worker supports schedule triggers", func() {
+		It("should deliver schedules published as events as schedule triggers", func() {
+			adapter := &scheduleTriggerAdapter{supported: true}
+			sw := NewScheduleWorker(adapter, &ScheduleWorkerOptions{Key: "nightly cleanup", Rate: "1 day"})
+
+			Expect(sw.HandleEvent(context.TODO(), legacyEvent)).To(Succeed())
+
+			Expect(adapter.events).To(BeEmpty())
+			Expect(adapter.schedules).To(ConsistOf(&triggers.Schedule{
+				Key:           "nightly cleanup",
+				ScheduledTime: publishTime,
+				FireTime:      publishTime,
+				Cadence:       "1 day",
+			}))
+		})
+	})
+
+	When("the worker does not support schedule triggers", func() {
+		It("should deliver schedules published as events unchanged", func() {
+			adapter := &scheduleTriggerAdapter{}
+			sw := NewScheduleWorker(adapter, &ScheduleWorkerOptions{Key: "nightly cleanup"})
+
+			Expect(sw.HandleEvent(context.TODO(), legacyEvent)).To(Succeed())
+
+			Expect(adapter.schedules).To(BeEmpty())
+			Expect(adapter.events).To(ConsistOf(legacyEvent))
+		})
+
+		It("should deliver schedule firings as events to the schedule's topic", func() {
+			adapter := &scheduleTriggerAdapter{}
+			sw := NewScheduleWorker(adapter, &ScheduleWorkerOptions{Key: "nightly cleanup"})
+
+			Expect(sw.HandleSchedule(context.TODO(), &triggers.Schedule{
+				Key:      "nightly cleanup",
+				FireTime: publishTime,
+			})).To(Succeed())
+
+			Expect(adapter.schedules).To(BeEmpty())
+			Expect(adapter.events).To(HaveLen(1))
+			Expect(adapter.events[0].Topic).To(Equal("nightly-cleanup"))
+			Expect(adapter.events[0].PublishTime).To(Equal(publishTime))
+		})
+	})
+})
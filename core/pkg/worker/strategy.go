@@ -0,0 +1,156 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+)
+
+// SelectionStrategy - Selects the worker to deliver a trigger to, from the workers able to handle it
+type SelectionStrategy interface {
+	// Select - returns one of the given workers, candidates will never be empty
+	Select(candidates []Worker) Worker
+}
+
+// InFlightCounter - implemented by workers and adapters that track the triggers they are currently handling
+type InFlightCounter interface {
+	InFlight() int
+}
+
+// workerLayers - returns the worker, the workers it decorates and the adapter of its base worker, outermost first
+func workerLayers(w Worker) []interface{} {
+	layers := []interface{}{}
+
+	for {
+		layers = append(layers, w)
+
+		var adapter Adapter
+
+		switch wt := w.(type) {
+		case unwrapper:
+			w = wt.Unwrap()
+			continue
+		case *RouteWorker:
+			adapter = wt.Adapter
+		case *SubscriptionWorker:
+			adapter = wt.Adapter
+		case *ScheduleWorker:
+			adapter = wt.Adapter
+		case *WebsocketWorker:
+			adapter = wt.Adapter
+		case *FaasWorker:
+			adapter = wt.Adapter
+		}
+
+		if adapter != nil {
+			layers = append(layers, adapter)
+		}
+
+		return layers
+	}
+}
+
+// workerInFlight - returns the number of triggers the worker is currently handling, or 0 if it doesn't track them
+func workerInFlight(w Worker) int {
+	for _, layer := range workerLayers(w) {
+		if c, ok := layer.(InFlightCounter); ok {
+			return c.InFlight()
+		}
+	}
+
+	return 0
+}
+
+// workerHasCapacity - returns true if the worker can handle a trigger without waiting, or doesn't limit its concurrency
+func workerHasCapacity(w Worker) bool {
+	for _, layer := range workerLayers(w) {
+		if c, ok := layer.(CapacityReporter); ok {
+			return c.HasCapacity()
+		}
+	}
+
+	return true
+}
+
+// FirstStrategy - Always selects the first candidate
+type FirstStrategy struct{}
+
+func (*FirstStrategy) Select(candidates []Worker) Worker {
+	return candidates[0]
+}
+
+// RoundRobinStrategy - Cycles through the candidates on each selection
+type RoundRobinStrategy struct {
+	next uint64
+}
+
+func (s *RoundRobinStrategy) Select(candidates []Worker) Worker {
+	n := atomic.AddUint64(&s.next, 1) - 1
+
+	return candidates[n%uint64(len(candidates))]
+}
+
+// LeastInFlightStrategy - Selects the candidate currently handling the fewest triggers,
+// preferring earlier candidates when tied
+type LeastInFlightStrategy struct{}
+
+func (*LeastInFlightStrategy) Select(candidates []Worker) Worker {
+	selected := candidates[0]
+	least := workerInFlight(selected)
+
+	for _, w := range candidates[1:] {
+		if inFlight := workerInFlight(w); inFlight < least {
+			selected = w
+			least = inFlight
+		}
+	}
+
+	return selected
+}
+
+// RandomStrategy - Selects a candidate at random
+type RandomStrategy struct{}
+
+func (*RandomStrategy) Select(candidates []Worker) Worker {
+	return candidates[rand.Intn(len(candidates))]
+}
+
+var (
+	_ SelectionStrategy = &FirstStrategy{}
+	_ SelectionStrategy = &RoundRobinStrategy{}
+	_ SelectionStrategy = &LeastInFlightStrategy{}
+	_ SelectionStrategy = &RandomStrategy{}
+)
+
+var strategies = []string{"first", "round-robin", "least-in-flight", "random"}
+
+// StrategyFromString - returns a new instance of the named selection strategy
+func StrategyFromString(name string) (SelectionStrategy, error) {
+	switch name {
+	case "first":
+		return &FirstStrategy{}, nil
+	case "round-robin":
+		return &RoundRobinStrategy{}, nil
+	case "least-in-flight":
+		return &LeastInFlightStrategy{}, nil
+	case "random":
+		return &RandomStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("invalid worker strategy %s, supported strategies are: %s", name, strings.Join(strategies, ", "))
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+func newRouteWorkerWithInFlight(inFlight int64) *RouteWorker {
+	return NewRouteWorker(&GrpcAdapter{
+		responseQueueLock: &sync.Mutex{},
+		inFlight:          inFlight,
+	}, &RouteWorkerOptions{
+		Path:    "/test",
+		Methods: []string{"GET"},
+	})
+}
+
+var _ = Describe("SelectionStrategy", func() {
+	busy := newRouteWorkerWithInFlight(3)
+	idle := newRouteWorkerWithInFlight(0)
+	candidates := []Worker{busy, idle}
+
+	When("using the RoundRobinStrategy", func() {
+		It("should cycle through the candidates", func() {
+			strategy := &RoundRobinStrategy{}
+
+			Expect(strategy.Select(candidates)).To(Equal(busy))
+			Expect(strategy.Select(candidates)).To(Equal(idle))
+			Expect(strategy.Select(candidates)).To(Equal(busy))
+		})
+	})
+
+	When("using the LeastInFlightStrategy", func() {
+		It("should select the worker with the fewest in-flight triggers", func() {
+			Expect((&LeastInFlightStrategy{}).Select(candidates)).To(Equal(idle))
+		})
+
+		It("should see through instrumented workers", func() {
+			Expect((&LeastInFlightStrategy{}).Select([]Worker{
+				InstrumentedWorkerFn(busy),
+				InstrumentedWorkerFn(idle),
+			})).To(Equal(InstrumentedWorkerFn(idle)))
+		})
+	})
+
+	When("using a pool with multiple workers for the same route", func() {
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10})
+		Expect(pp.AddWorker(busy)).To(Succeed())
+		Expect(pp.AddWorker(idle)).To(Succeed())
+
+		It("should select using the requested strategy", func() {
+			wrkr, err := pp.GetWorker(&GetWorkerOptions{
+				Http:     &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				Strategy: &LeastInFlightStrategy{},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(wrkr).To(Equal(idle))
+		})
+
+		It("should select the first worker by default", func() {
+			for i := 0; i < 2; i++ {
+				wrkr, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(wrkr).To(Equal(busy))
+			}
+		})
+	})
+
+	When("using a round-robin pool with multiple workers for the same route", func() {
+		pp := NewProcessPool(&ProcessPoolOptions{MaxWorkers: 10, Strategy: &RoundRobinStrategy{}})
+		Expect(pp.AddWorker(busy)).To(Succeed())
+		Expect(pp.AddWorker(idle)).To(Succeed())
+
+		It("should distribute requests using the pool's strategy", func() {
+			selected := map[Worker]bool{}
+			for i := 0; i < 2; i++ {
+				wrkr, err := pp.GetWorker(&GetWorkerOptions{
+					Http: &triggers.HttpRequest{Method: "GET", Path: "/test"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				selected[wrkr] = true
+			}
+
+			Expect(selected).To(HaveLen(2))
+		})
+	})
+
+	When("parsing a strategy name", func() {
+		It("should return an error for unknown strategies", func() {
+			_, err := StrategyFromString("fastest")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,90 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+// WebsocketHandler - Handles connection events and messages received on websockets
+type WebsocketHandler interface {
+	HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error
+}
+
+// WebsocketWorker - Worker representation for a websocket event handler
+type WebsocketWorker struct {
+	socket string
+	event  triggers.WebsocketEvent
+	Adapter
+}
+
+var _ Worker = &WebsocketWorker{}
+
+var _ WebsocketHandler = &WebsocketWorker{}
+
+func (s *WebsocketWorker) Socket() string {
+	return s.socket
+}
+
+func (s *WebsocketWorker) Event() triggers.WebsocketEvent {
+	return s.event
+}
+
+func (s *WebsocketWorker) HandlesHttpRequest(trigger *triggers.HttpRequest) bool {
+	return false
+}
+
+func (s *WebsocketWorker) HandlesEvent(trigger *triggers.Event) bool {
+	return false
+}
+
+func (s *WebsocketWorker) HandlesWebsocketMessage(trigger *triggers.WebsocketMessage) bool {
+	return trigger.Socket == s.socket && trigger.Event == s.event
+}
+
+func (s *WebsocketWorker) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return nil, fmt.Errorf("websocket workers cannot handle HTTP requests")
+}
+
+func (s *WebsocketWorker) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return fmt.Errorf("websocket workers cannot handle events")
+}
+
+func (s *WebsocketWorker) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	wh, ok := s.Adapter.(WebsocketHandler)
+	if !ok {
+		return fmt.Errorf("worker adapter cannot handle websocket messages")
+	}
+
+	return wh.HandleWebsocketMessage(ctx, trigger)
+}
+
+type WebsocketWorkerOptions struct {
+	Socket string
+	Event  triggers.WebsocketEvent
+}
+
+// Package private method
+// Only a pool may create a new faas worker
+func NewWebsocketWorker(adapter Adapter, opts *WebsocketWorkerOptions) *WebsocketWorker {
+	return &WebsocketWorker{
+		socket:  opts.Socket,
+		event:   opts.Event,
+		Adapter: adapter,
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/triggers"
+)
+
+type mockWebsocketAdapter struct {
+	UnimplementedWorker
+	received []*triggers.WebsocketMessage
+}
+
+func (m *mockWebsocketAdapter) HandleEvent(ctx context.Context, trigger *triggers.Event) error {
+	return m.UnimplementedWorker.HandleEvent(trigger)
+}
+
+func (m *mockWebsocketAdapter) HandleHttpRequest(ctx context.Context, trigger *triggers.HttpRequest) (*triggers.HttpResponse, error) {
+	return m.UnimplementedWorker.HandleHttpRequest(trigger)
+}
+
+func (m *mockWebsocketAdapter) HandleWebsocketMessage(ctx context.Context, trigger *triggers.WebsocketMessage) error {
+	m.received = append(m.received, trigger)
+	return nil
+}
+
+var _ = Describe("WebsocketWorker", func() {
+	Context("Http", func() {
+		wsWrkr := &WebsocketWorker{}
+
+		When("calling HandlesHttpRequest", func() {
+			It("should return false", func() {
+				Expect(wsWrkr.HandlesHttpRequest(&triggers.HttpRequest{})).To(BeFalse())
+			})
+		})
+
+		When("calling HandleHttpRequest", func() {
+			It("should return an error", func() {
+				_, err := wsWrkr.HandleHttpRequest(context.TODO(), &triggers.HttpRequest{})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Event", func() {
+		When("calling HandlesEvent", func() {
+			It("should return false", func() {
+				Expect((&WebsocketWorker{}).HandlesEvent(&triggers.Event{})).To(BeFalse())
+			})
+		})
+	})
+
+	Context("Websocket", func() {
+		wsWrkr := NewWebsocketWorker(nil, &WebsocketWorkerOptions{
+			Socket: "chat",
+			Event:  triggers.WebsocketEvent_Message,
+		})
+
+		When("calling HandlesWebsocketMessage with the matching socket and event", func() {
+			It("should return true", func() {
+				Expect(wsWrkr.HandlesWebsocketMessage(&triggers.WebsocketMessage{
+					Socket: "chat",
+					Event:  triggers.WebsocketEvent_Message,
+				})).To(BeTrue())
+			})
+		})
+
+		When("calling HandlesWebsocketMessage with a different event", func() {
+			It("should return false", func() {
+				Expect(wsWrkr.HandlesWebsocketMessage(&triggers.WebsocketMessage{
+					Socket: "chat",
+					Event:  triggers.WebsocketEvent_Connect,
+				})).To(BeFalse())
+			})
+		})
+
+		When("calling HandlesWebsocketMessage with a different socket", func() {
+			It("should return false", func() {
+				Expect(wsWrkr.HandlesWebsocketMessage(&triggers.WebsocketMessage{
+					Socket: "other",
+					Event:  triggers.WebsocketEvent_Message,
+				})).To(BeFalse())
+			})
+		})
+
+		When("the adapter cannot handle websocket messages", func() {
+			It("should return an error", func() {
+				err := wsWrkr.HandleWebsocketMessage(context.TODO(), &triggers.WebsocketMessage{})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("DispatchWebsocketMessage", func() {
+		When("a worker is registered for the socket event", func() {
+			adapter := &mockWebsocketAdapter{}
+			pool := NewProcessPool(&ProcessPoolOptions{})
+			_ = pool.AddWorker(NewWebsocketWorker(adapter, &WebsocketWorkerOptions{
+				Socket: "chat",
+				Event:  triggers.WebsocketEvent_Connect,
+			}))
+
+			It("should deliver the message to the worker", func() {
+				msg := &triggers.WebsocketMessage{
+					Socket:       "chat",
+					Event:        triggers.WebsocketEvent_Connect,
+					ConnectionID: "conn-1",
+				}
+
+				err := DispatchWebsocketMessage(context.TODO(), pool, msg)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(adapter.received).To(ConsistOf(msg))
+			})
+		})
+
+		When("no worker is registered for the socket event", func() {
+			pool := NewProcessPool(&ProcessPoolOptions{})
+
+			It("should return an error", func() {
+				err := DispatchWebsocketMessage(context.TODO(), pool, &triggers.WebsocketMessage{
+					Socket: "chat",
+					Event:  triggers.WebsocketEvent_Disconnect,
+				})
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -30,6 +30,43 @@ type Worker interface {
 	Adapter
 }
 
+// unwrapper - implemented by workers that decorate another worker
+type unwrapper interface {
+	Unwrap() Worker
+}
+
+// BaseWorker - returns the worker underlying any decorating workers, such as instrumentation
+func BaseWorker(w Worker) Worker {
+	for {
+		u, ok := w.(unwrapper)
+		if !ok {
+			return w
+		}
+
+		w = u.Unwrap()
+	}
+}
+
+// TypeOf - a short name for the type of trigger the worker was registered to handle
+func TypeOf(w Worker) string {
+	switch BaseWorker(w).(type) {
+	case *RouteWorker:
+		return "route"
+	case *SubscriptionWorker:
+		return "subscription"
+	case *ScheduleWorker:
+		return "schedule"
+	case *WebsocketWorker:
+		return "websocket"
+	case *HttpWorker:
+		return "http"
+	case *FaasWorker:
+		return "faas"
+	default:
+		return "unknown"
+	}
+}
+
 type UnimplementedWorker struct{}
 
 func (*UnimplementedWorker) HandlesEvent(trigger *triggers.Event) bool {